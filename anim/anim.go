@@ -0,0 +1,255 @@
+package anim
+
+import (
+	"errors"
+	"fmt"
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	ast "github.com/shubhamdwivedii/scene-engine/asset"
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
+)
+
+type Mode int
+
+const (
+	Loop     Mode = iota // 0,1,2,0,1,2...
+	PingPong             // 0,1,2,1,0,1...
+	Once                 // 0,1,2 (stays on last frame)
+)
+
+type Frame struct {
+	Image     *ebiten.Image
+	Duration  float64     // Seconds
+	Event     string      // Passed to Player.OnEvent when this frame starts ("" = No event)
+	Placement ebiten.GeoM // Places Image inside the frame (trim offset and rotation of sheet frames)
+	Size      image.Point // Untrimmed frame size, flipping is around its center (Image size if zero)
+}
+
+func (f *Frame) size() (float64, float64) {
+	if f.Size.X > 0 && f.Size.Y > 0 {
+		return float64(f.Size.X), float64(f.Size.Y)
+	}
+	return float64(f.Image.Bounds().Dx()), float64(f.Image.Bounds().Dy())
+}
+
+// Clip is a named sequence of frames
+type Clip struct {
+	Name   string
+	Frames []Frame
+	Mode   Mode
+}
+
+// Every frame gets same duration (in seconds)
+func NewClip(name string, mode Mode, frameDuration float64, images ...*ebiten.Image) *Clip {
+	clip := &Clip{Name: name, Mode: mode}
+	for _, img := range images {
+		clip.Frames = append(clip.Frames, Frame{Image: img, Duration: frameDuration})
+	}
+	return clip
+}
+
+// Builds clip from an Aseprite tag, frames without duration get defaultDuration
+// Tags with "pingpong" direction always use PingPong mode
+func ClipFromSheet(sheet *ast.Sheet, tag string, mode Mode, defaultDuration float64) (*Clip, error) {
+	frames, err := sheet.TagFrames(tag)
+	if err != nil {
+		return nil, err
+	}
+	if sheet.Tags[tag].Direction == "pingpong" {
+		mode = PingPong
+	}
+	clip := &Clip{Name: tag, Mode: mode}
+	for _, frame := range frames {
+		duration := frame.Duration
+		if duration <= 0 {
+			duration = defaultDuration
+		}
+		clip.Frames = append(clip.Frames, Frame{
+			Image:     frame.Image,
+			Duration:  duration,
+			Placement: frame.GeoM(),
+			Size:      frame.SourceSize,
+		})
+	}
+	return clip, nil
+}
+
+// Sets Event on frame at index (for footsteps, attack hit frames etc.)
+func (c *Clip) SetEvent(index int, event string) *Clip {
+	if index >= 0 && index < len(c.Frames) {
+		c.Frames[index].Event = event
+	}
+	return c
+}
+
+// Player plays one Clip at a time, driven by Clock (clock.Default if not set)
+type Player struct {
+	Clips    map[string]*Clip
+	Current  *Clip
+	Frame    int     // Index of current frame in Current clip
+	Speed    float64 // 1.0 is normal speed
+	FlipX    bool
+	FlipY    bool
+	Clock    clk.Clock
+	OnEvent  func(clip *Clip, event string)
+	OnFinish func(clip *Clip) // Called when a Once clip's last frame has been shown for its full Duration
+	elapsed  float64
+	backward bool // PingPong direction
+	finished bool
+	drawOP   *ebiten.DrawImageOptions
+}
+
+func NewPlayer(clips ...*Clip) *Player {
+	p := &Player{
+		Clips:  map[string]*Clip{},
+		Speed:  1.0,
+		Clock:  clk.Default,
+		drawOP: &ebiten.DrawImageOptions{},
+	}
+	for _, clip := range clips {
+		p.Add(clip)
+	}
+	return p
+}
+
+// First added clip starts playing
+func (p *Player) Add(clip *Clip) {
+	p.Clips[clip.Name] = clip
+	if p.Current == nil {
+		p.start(clip)
+	}
+}
+
+// Does nothing if clip is already playing (use Restart to play from first frame)
+func (p *Player) Play(name string) error {
+	if p.Current != nil && p.Current.Name == name {
+		return nil
+	}
+	return p.Restart(name)
+}
+
+func (p *Player) Restart(name string) error {
+	clip, ok := p.Clips[name]
+	if !ok {
+		return fmt.Errorf("clip %q not found", name)
+	}
+	p.start(clip)
+	return nil
+}
+
+func (p *Player) start(clip *Clip) {
+	p.Current = clip
+	p.Frame = 0
+	p.elapsed = 0
+	p.backward = false
+	p.finished = false
+	p.fireEvent()
+}
+
+// True once a Once clip's last frame has been shown for its full Duration (not when it starts)
+// Last frame stays visible after that, so chaining clips (attack -> idle) on Finished doesn't cut it short
+func (p *Player) Finished() bool {
+	return p.finished
+}
+
+func (p *Player) SetClock(clock clk.Clock) {
+	p.Clock = clock
+}
+
+func (p *Player) Update() error {
+	if p.Current == nil {
+		return errors.New("no clip to play")
+	}
+	if p.finished || len(p.Current.Frames) == 0 {
+		return nil
+	}
+
+	p.elapsed += p.Clock.Delta() * p.Speed
+	for !p.finished {
+		duration := p.Current.Frames[p.Frame].Duration
+		if duration <= 0 || p.elapsed < duration {
+			break
+		}
+		p.elapsed -= duration
+		p.advance()
+	}
+	return nil
+}
+
+func (p *Player) advance() {
+	last := len(p.Current.Frames) - 1
+	switch p.Current.Mode {
+	case Once:
+		if p.Frame >= last {
+			p.finished = true
+			if p.OnFinish != nil {
+				p.OnFinish(p.Current)
+			}
+			return
+		}
+		p.Frame++
+	case PingPong:
+		if last == 0 {
+			return
+		}
+		if p.backward && p.Frame == 0 {
+			p.backward = false
+		} else if !p.backward && p.Frame == last {
+			p.backward = true
+		}
+		if p.backward {
+			p.Frame--
+		} else {
+			p.Frame++
+		}
+	default:
+		p.Frame = (p.Frame + 1) % len(p.Current.Frames)
+	}
+	p.fireEvent()
+}
+
+func (p *Player) fireEvent() {
+	if p.OnEvent == nil || len(p.Current.Frames) == 0 {
+		return
+	}
+	if event := p.Current.Frames[p.Frame].Event; event != "" {
+		p.OnEvent(p.Current, event)
+	}
+}
+
+// Image of current frame (nil if nothing is playing)
+func (p *Player) Image() *ebiten.Image {
+	if p.Current == nil || len(p.Current.Frames) == 0 {
+		return nil
+	}
+	return p.Current.Frames[p.Frame].Image
+}
+
+// Draws current frame, flipping is done in place (around frame center) before op.GeoM
+// Trimmed/rotated sheet frames are placed inside their untrimmed frame (so they don't jitter)
+func (p *Player) Draw(canvas scr.Canvas, op *ebiten.DrawImageOptions) {
+	if p.Image() == nil {
+		return
+	}
+	frame := &p.Current.Frames[p.Frame]
+	*p.drawOP = *op
+	p.drawOP.GeoM = frame.Placement
+	if p.FlipX || p.FlipY {
+		w, h := frame.size()
+		sx, sy := 1.0, 1.0
+		if p.FlipX {
+			sx = -1
+		}
+		if p.FlipY {
+			sy = -1
+		}
+		p.drawOP.GeoM.Translate(-w/2, -h/2)
+		p.drawOP.GeoM.Scale(sx, sy)
+		p.drawOP.GeoM.Translate(w/2, h/2)
+	}
+	p.drawOP.GeoM.Concat(op.GeoM)
+	canvas.DrawImage(frame.Image, p.drawOP)
+}
@@ -0,0 +1,279 @@
+package asset
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// Cache loads each image (and sprite sheet) only once, so entities share one texture
+type Cache struct {
+	mutex  sync.Mutex
+	images map[string]*ebiten.Image
+	sheets map[string]*Sheet
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		images: map[string]*ebiten.Image{},
+		sheets: map[string]*Sheet{},
+	}
+}
+
+// Shared cache used by package level LoadImage and LoadSheet
+var Default = NewCache()
+
+func LoadImage(path string) (*ebiten.Image, error) {
+	return Default.LoadImage(path)
+}
+
+func LoadSheet(path string) (*Sheet, error) {
+	return Default.LoadSheet(path)
+}
+
+func (c *Cache) LoadImage(path string) (*ebiten.Image, error) {
+	key := filepath.Clean(path)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if img, ok := c.images[key]; ok {
+		return img, nil
+	}
+	img, _, err := ebitenutil.NewImageFromFile(key)
+	if err != nil {
+		return nil, err
+	}
+	c.images[key] = img
+	return img, nil
+}
+
+// Loads JSON descriptor exported by Aseprite or TexturePacker (Hash or Array format)
+// Image path in "meta.image" is relative to the JSON file
+func (c *Cache) LoadSheet(path string) (*Sheet, error) {
+	key := filepath.Clean(path)
+	c.mutex.Lock()
+	sheet, ok := c.sheets[key]
+	c.mutex.Unlock()
+	if ok {
+		return sheet, nil
+	}
+
+	data, err := os.ReadFile(key)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := parseDescriptor(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+
+	img, err := c.LoadImage(filepath.Join(filepath.Dir(key), desc.Meta.Image))
+	if err != nil {
+		return nil, err
+	}
+	sheet = newSheet(img, desc)
+
+	c.mutex.Lock()
+	c.sheets[key] = sheet
+	c.mutex.Unlock()
+	return sheet, nil
+}
+
+// Frame is a named area of the sheet, Image is a sub-image sharing the sheet texture
+// Image is stored as packed (trimmed and maybe rotated), draw it with GeoM to get the original frame
+type Frame struct {
+	Name       string
+	Image      *ebiten.Image
+	Rect       image.Rectangle
+	Duration   float64     // Seconds (Aseprite frame duration, 0 if not set)
+	Rotated    bool        // Image is stored rotated 90° clockwise
+	Offset     image.Point // Top-left of trimmed Image inside the original frame
+	SourceSize image.Point // Size of the original (untrimmed) frame
+}
+
+// Places Image inside the original frame (undoes rotation, adds trim offset)
+// Concat your own transform after this one
+func (f *Frame) GeoM() ebiten.GeoM {
+	m := ebiten.GeoM{}
+	if f.Rotated {
+		// Stored width is original height, rotate back 90° counter-clockwise
+		m.Rotate(-math.Pi / 2)
+		m.Translate(0, float64(f.Rect.Dx()))
+	}
+	m.Translate(float64(f.Offset.X), float64(f.Offset.Y))
+	return m
+}
+
+// Tag is a named range of frames (Aseprite frameTags), Direction is "forward", "reverse" or "pingpong"
+type Tag struct {
+	Name      string
+	From      int
+	To        int
+	Direction string
+}
+
+type Sheet struct {
+	Image  *ebiten.Image
+	Frames []*Frame // In descriptor order
+	Tags   map[string]Tag
+	byName map[string]*Frame
+}
+
+func (s *Sheet) Frame(name string) (*Frame, bool) {
+	frame, ok := s.byName[name]
+	return frame, ok
+}
+
+// Frames of a tag in playback order ("reverse" is reversed, "pingpong" is kept forward)
+func (s *Sheet) TagFrames(name string) ([]*Frame, error) {
+	tag, ok := s.Tags[name]
+	if !ok {
+		return nil, fmt.Errorf("tag %q not found", name)
+	}
+	if tag.From < 0 || tag.To >= len(s.Frames) || tag.From > tag.To {
+		return nil, fmt.Errorf("tag %q has invalid frame range %d-%d", name, tag.From, tag.To)
+	}
+	frames := make([]*Frame, 0, tag.To-tag.From+1)
+	for i := tag.From; i <= tag.To; i++ {
+		frames = append(frames, s.Frames[i])
+	}
+	if tag.Direction == "reverse" {
+		for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+			frames[i], frames[j] = frames[j], frames[i]
+		}
+	}
+	return frames, nil
+}
+
+func newSheet(img *ebiten.Image, desc *descriptor) *Sheet {
+	sheet := &Sheet{
+		Image:  img,
+		Tags:   map[string]Tag{},
+		byName: map[string]*Frame{},
+	}
+	for _, f := range desc.Frames {
+		rect, offset, size := f.layout()
+		frame := &Frame{
+			Name:       f.Filename,
+			Image:      img.SubImage(rect).(*ebiten.Image),
+			Rect:       rect,
+			Duration:   float64(f.Duration) / 1000,
+			Rotated:    f.Rotated,
+			Offset:     offset,
+			SourceSize: size,
+		}
+		sheet.Frames = append(sheet.Frames, frame)
+		sheet.byName[frame.Name] = frame
+	}
+	for _, t := range desc.Meta.FrameTags {
+		sheet.Tags[t.Name] = Tag{Name: t.Name, From: t.From, To: t.To, Direction: t.Direction}
+	}
+	return sheet
+}
+
+// Common subset of Aseprite and TexturePacker JSON
+type descriptor struct {
+	Frames []descriptorFrame
+	Meta   struct {
+		Image     string `json:"image"`
+		FrameTags []struct {
+			Name      string `json:"name"`
+			From      int    `json:"from"`
+			To        int    `json:"to"`
+			Direction string `json:"direction"`
+		} `json:"frameTags"`
+	}
+}
+
+type descriptorRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+type descriptorFrame struct {
+	Filename         string         `json:"filename"`
+	Frame            descriptorRect `json:"frame"`            // Unrotated size
+	Rotated          bool           `json:"rotated"`          // Stored 90° clockwise
+	SpriteSourceSize descriptorRect `json:"spriteSourceSize"` // Trimmed area inside SourceSize (0,0 if not trimmed)
+	SourceSize       struct {
+		W int `json:"w"`
+		H int `json:"h"`
+	} `json:"sourceSize"`
+	Duration int `json:"duration"` // Milliseconds (Aseprite)
+}
+
+// Area in the texture, trim offset and original (untrimmed) size
+func (f *descriptorFrame) layout() (rect image.Rectangle, offset, size image.Point) {
+	rect = image.Rect(f.Frame.X, f.Frame.Y, f.Frame.X+f.Frame.W, f.Frame.Y+f.Frame.H)
+	if f.Rotated {
+		// Rotated frames are stored 90° clockwise (width and height swapped in the texture)
+		rect = image.Rect(f.Frame.X, f.Frame.Y, f.Frame.X+f.Frame.H, f.Frame.Y+f.Frame.W)
+	}
+	size = image.Pt(f.Frame.W, f.Frame.H)
+	if f.SourceSize.W > 0 && f.SourceSize.H > 0 {
+		size = image.Pt(f.SourceSize.W, f.SourceSize.H)
+	}
+	offset = image.Pt(f.SpriteSourceSize.X, f.SpriteSourceSize.Y)
+	return rect, offset, size
+}
+
+// "frames" is an object (Hash format) or an array (Array format)
+func parseDescriptor(data []byte) (*descriptor, error) {
+	var raw struct {
+		Frames json.RawMessage `json:"frames"`
+		Meta   json.RawMessage `json:"meta"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if len(raw.Frames) == 0 {
+		return nil, errors.New("sprite sheet has no frames")
+	}
+
+	desc := &descriptor{}
+	if len(raw.Meta) > 0 {
+		if err := json.Unmarshal(raw.Meta, &desc.Meta); err != nil {
+			return nil, err
+		}
+	}
+	if desc.Meta.Image == "" {
+		return nil, errors.New("sprite sheet has no meta.image")
+	}
+
+	if raw.Frames[0] == '[' {
+		if err := json.Unmarshal(raw.Frames, &desc.Frames); err != nil {
+			return nil, err
+		}
+		return desc, nil
+	}
+
+	// Hash format, decoded key by key to keep frame order of the file (frame indices used by tags)
+	decoder := json.NewDecoder(bytes.NewReader(raw.Frames))
+	if _, err := decoder.Token(); err != nil { // {
+		return nil, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var frame descriptorFrame
+		if err := decoder.Decode(&frame); err != nil {
+			return nil, err
+		}
+		frame.Filename = token.(string)
+		desc.Frames = append(desc.Frames, frame)
+	}
+	return desc, nil
+}
@@ -0,0 +1,167 @@
+package asset
+
+import (
+	"image"
+	"reflect"
+	"testing"
+)
+
+func TestParseDescriptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		frames  []string // Expected frame names in order
+		image   string
+		wantErr bool
+	}{
+		{
+			name: "hash keeps file order",
+			json: `{"frames": {
+				"walk 2": {"frame": {"x": 32, "y": 0, "w": 16, "h": 16}},
+				"walk 0": {"frame": {"x": 0, "y": 0, "w": 16, "h": 16}},
+				"walk 1": {"frame": {"x": 16, "y": 0, "w": 16, "h": 16}}
+			}, "meta": {"image": "walk.png"}}`,
+			frames: []string{"walk 2", "walk 0", "walk 1"},
+			image:  "walk.png",
+		},
+		{
+			name: "array",
+			json: `{"frames": [
+				{"filename": "a", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}},
+				{"filename": "b", "frame": {"x": 8, "y": 0, "w": 8, "h": 8}}
+			], "meta": {"image": "sheet.png"}}`,
+			frames: []string{"a", "b"},
+			image:  "sheet.png",
+		},
+		{
+			name:    "missing meta.image",
+			json:    `{"frames": [{"filename": "a", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}}], "meta": {}}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing meta",
+			json:    `{"frames": [{"filename": "a", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "no frames",
+			json:    `{"meta": {"image": "sheet.png"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			json:    `{"frames": [`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desc, err := parseDescriptor([]byte(tt.json))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, f := range desc.Frames {
+				names = append(names, f.Filename)
+			}
+			if !reflect.DeepEqual(names, tt.frames) {
+				t.Errorf("frames = %v, want %v", names, tt.frames)
+			}
+			if desc.Meta.Image != tt.image {
+				t.Errorf("meta.image = %q, want %q", desc.Meta.Image, tt.image)
+			}
+		})
+	}
+}
+
+func TestParseDescriptorTags(t *testing.T) {
+	desc, err := parseDescriptor([]byte(`{"frames": [{"filename": "a", "frame": {"x": 0, "y": 0, "w": 8, "h": 8}, "duration": 100}],
+		"meta": {"image": "sheet.png", "frameTags": [{"name": "idle", "from": 0, "to": 0, "direction": "pingpong"}]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Frames[0].Duration != 100 {
+		t.Errorf("duration = %d, want 100", desc.Frames[0].Duration)
+	}
+	if len(desc.Meta.FrameTags) != 1 || desc.Meta.FrameTags[0].Name != "idle" || desc.Meta.FrameTags[0].Direction != "pingpong" {
+		t.Errorf("frameTags = %+v", desc.Meta.FrameTags)
+	}
+}
+
+func TestFrameLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		json   string
+		rect   image.Rectangle
+		offset image.Point
+		size   image.Point
+	}{
+		{
+			name: "plain",
+			json: `{"frame": {"x": 4, "y": 8, "w": 16, "h": 32}}`,
+			rect: image.Rect(4, 8, 20, 40),
+			size: image.Pt(16, 32),
+		},
+		{
+			name: "trimmed",
+			json: `{"frame": {"x": 0, "y": 0, "w": 10, "h": 12}, "trimmed": true,
+				"spriteSourceSize": {"x": 3, "y": 2, "w": 10, "h": 12}, "sourceSize": {"w": 16, "h": 16}}`,
+			rect:   image.Rect(0, 0, 10, 12),
+			offset: image.Pt(3, 2),
+			size:   image.Pt(16, 16),
+		},
+		{
+			name: "rotated and trimmed",
+			json: `{"frame": {"x": 20, "y": 0, "w": 10, "h": 12}, "rotated": true, "trimmed": true,
+				"spriteSourceSize": {"x": 1, "y": 4, "w": 10, "h": 12}, "sourceSize": {"w": 16, "h": 16}}`,
+			rect:   image.Rect(20, 0, 32, 10),
+			offset: image.Pt(1, 4),
+			size:   image.Pt(16, 16),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desc, err := parseDescriptor([]byte(`{"frames": {"f": ` + tt.json + `}, "meta": {"image": "sheet.png"}}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			rect, offset, size := desc.Frames[0].layout()
+			if rect != tt.rect || offset != tt.offset || size != tt.size {
+				t.Errorf("layout = (%v, %v, %v), want (%v, %v, %v)", rect, offset, size, tt.rect, tt.offset, tt.size)
+			}
+		})
+	}
+}
+
+func TestFrameGeoMRotated(t *testing.T) {
+	// 10x12 frame stored rotated (12x10 in texture) at offset (1, 4)
+	frame := &Frame{Rect: image.Rect(20, 0, 32, 10), Rotated: true, Offset: image.Pt(1, 4)}
+	m := frame.GeoM()
+	tests := []struct {
+		storedX, storedY float64
+		wantX, wantY     float64
+	}{
+		{12, 0, 1, 4},   // Top-right of stored image is top-left of frame
+		{12, 10, 11, 4}, // Bottom-right is top-right
+		{0, 0, 1, 16},   // Top-left is bottom-left
+	}
+	for _, tt := range tests {
+		x, y := m.Apply(tt.storedX, tt.storedY)
+		if abs(x-tt.wantX) > 1e-9 || abs(y-tt.wantY) > 1e-9 {
+			t.Errorf("(%v, %v) -> (%v, %v), want (%v, %v)", tt.storedX, tt.storedY, x, y, tt.wantX, tt.wantY)
+		}
+	}
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,55 @@
+package camera
+
+import (
+	"golang.org/x/image/math/f64"
+)
+
+// Limits the camera so the visible area (ViewArea + ViewMargin) never leaves Bounds
+// minX, minY, maxX, maxY are in World coordinates (WorldView by default)
+func (c *Camera) SetBounds(minX, minY, maxX, maxY float64) {
+	c.Bounds = f64.Vec4{minX, minY, maxX, maxY}
+	c.ClampToBounds = true
+	c.clamp()
+}
+
+// Area of the World visible on screen when camera offset is (0,0)
+// margin is extra area that can become visible (Viewport.Margin or AUTO_PADDING for shake)
+// Set automatically by screen.CustomScreen
+func (c *Camera) SetViewArea(x, y, width, height, margin float64) {
+	c.ViewArea = f64.Vec4{x, y, width, height}
+	c.ViewMargin = margin
+	c.clamp()
+}
+
+// Moves Position (and FocusCenter) back inside Bounds
+// If visible area is bigger than Bounds, camera is centered on Bounds instead
+func (c *Camera) clamp() {
+	if !c.ClampToBounds {
+		return
+	}
+	ox := clampOffset(c.Position[0]-c.WorldView[0]/2, c.ViewArea[0], c.ViewArea[2], c.ViewMargin, c.Bounds[0], c.Bounds[2])
+	oy := clampOffset(c.Position[1]-c.WorldView[1]/2, c.ViewArea[1], c.ViewArea[3], c.ViewMargin, c.Bounds[1], c.Bounds[3])
+
+	dx := ox + c.WorldView[0]/2 - c.Position[0]
+	dy := oy + c.WorldView[1]/2 - c.Position[1]
+	c.Position[0] += dx
+	c.Position[1] += dy
+	c.FocusCenter[0] += dx
+	c.FocusCenter[1] += dy
+}
+
+// offset is camera displacement on one axis, visible World span is [view-margin+offset, view+size+margin+offset]
+func clampOffset(offset, view, size, margin, min, max float64) float64 {
+	lo := min - view + margin
+	hi := max - view - size - margin
+	if lo > hi {
+		return (lo + hi) / 2
+	}
+	if offset < lo {
+		return lo
+	}
+	if offset > hi {
+		return hi
+	}
+	return offset
+}
@@ -3,6 +3,8 @@ package camera
 import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"golang.org/x/image/math/f64"
+
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
 )
 
 type FocusableEntity interface {
@@ -14,9 +16,24 @@ type Camera struct {
 	WorldView     f64.Vec2
 	FocusView     f64.Vec2
 	FocusCenter   f64.Vec2
-	Debug         bool
 	AutoFocus     bool
 	FocusedEntity FocusableEntity
+	Follow        FollowOptions
+	Clock         clk.Clock
+	Bounds        f64.Vec4 // minX, minY, maxX, maxY (Used if ClampToBounds is true)
+	ViewArea      f64.Vec4 // x, y, width, height of visible area (with zero camera offset)
+	ViewMargin    float64
+	ClampToBounds bool
+	Group         []FocusTarget
+	GroupOptions  GroupOptions
+	Zoomer        Zoomer
+	Controller    Controller
+	pathPlayer    *PathPlayer
+
+	springVelocity f64.Vec2
+	lookAhead      f64.Vec2
+	lastTarget     f64.Vec2
+	trackingEntity bool
 }
 
 // worldWidth, worldHeight is the width/height of the World (including out-of-screen area)
@@ -24,71 +41,70 @@ type Camera struct {
 // focusX, focusY is point of focus within the the WorldView (center of FocusView)
 func New(worldWidth, worldHeight, focusWidth, focusHeight int, focusX, focusY float64) *Camera {
 	return &Camera{
-		Position:    f64.Vec2{float64(worldWidth) / 2, float64(worldHeight) / 2},
-		WorldView:   f64.Vec2{float64(worldWidth), float64(worldHeight)},
-		FocusView:   f64.Vec2{float64(focusWidth), float64(focusHeight)},
-		FocusCenter: f64.Vec2{focusX, focusY},
+		Position:     f64.Vec2{float64(worldWidth) / 2, float64(worldHeight) / 2},
+		WorldView:    f64.Vec2{float64(worldWidth), float64(worldHeight)},
+		FocusView:    f64.Vec2{float64(focusWidth), float64(focusHeight)},
+		FocusCenter:  f64.Vec2{focusX, focusY},
+		Follow:       DefaultFollowOptions(),
+		Clock:        clk.Default,
+		Bounds:       f64.Vec4{0, 0, float64(worldWidth), float64(worldHeight)},
+		ViewArea:     f64.Vec4{0, 0, float64(worldWidth), float64(worldHeight)},
+		GroupOptions: DefaultGroupOptions(),
 	}
 	// ORIGIN is (0,0), FocusedEntity is nil
 }
 
+// Camera reads Delta from this clock every Update (clock.Default if not set)
+func (c *Camera) SetClock(clock clk.Clock) {
+	c.Clock = clock
+}
+
 func (c *Camera) Update() error {
-	if c.Debug {
-		if ebiten.IsKeyPressed(ebiten.KeyJ) {
-			c.MoveBy(-4, 0)
-		}
-
-		if ebiten.IsKeyPressed(ebiten.KeyL) {
-			c.MoveBy(4, 0)
-		}
-
-		if ebiten.IsKeyPressed(ebiten.KeyI) {
-			c.MoveBy(0, -4)
-		}
-
-		if ebiten.IsKeyPressed(ebiten.KeyK) {
-			c.MoveBy(0, 4)
-		}
-
-		if c.AutoFocus && c.FocusedEntity != nil {
-			xPos, yPos := c.FocusedEntity.GetPosition()
-			c.Refocus(xPos, yPos)
-		}
+	dt := c.Clock.Delta()
+
+	// Scripted path takes over the camera till it completes
+	if c.pathPlayer != nil {
+		c.pathPlayer.Update(dt)
+		return nil
+	}
+
+	if c.Controller != nil {
+		c.Controller.Update(c, dt)
+	}
+
+	if c.AutoFocus && c.FocusedEntity != nil {
+		xPos, yPos := c.FocusedEntity.GetPosition()
+		c.follow(xPos, yPos, dt)
+	} else if c.AutoFocus && len(c.Group) > 0 {
+		c.followGroup(dt)
 	}
 
 	return nil
 }
 
+// Controller (like FreeFly) is updated every Camera.Update, only one can be attached
+func (c *Camera) Attach(controller Controller) {
+	c.Controller = controller
+}
+
+func (c *Camera) Detach() {
+	c.Controller = nil
+}
+
 func (c *Camera) FocusOn(entity FocusableEntity) {
 	c.FocusedEntity = entity
+	c.Group = nil
 	c.AutoFocus = true
+	c.resetFollow()
 }
 
 func (c *Camera) DisableAutoFocus() {
 	c.AutoFocus = false
 }
 
+// Snaps camera so (x,y) is inside FocusView (ignores Follow.Mode)
 func (c *Camera) Refocus(x, y float64) {
-	dx, dy := 0.0, 0.0
-	lx := c.FocusCenter[0] - c.FocusView[0]/2
-	if x < lx {
-		dx = x - lx
-	}
-	rx := c.FocusCenter[0] + c.FocusView[0]/2
-	if x > rx {
-		dx = x - rx
-	}
-
-	ty := c.FocusCenter[1] - c.FocusView[1]/2
-	if y < ty {
-		dy = y - ty
-	}
-
-	by := c.FocusCenter[1] + c.FocusView[1]/2
-	if y > by {
-		dy = y - by
-	}
-
+	dx, dy := c.deadzoneDelta(x, y)
 	c.MoveBy(dx, dy)
 }
 
@@ -103,6 +119,7 @@ func (c *Camera) MoveBy(dx, dy float64) {
 	c.Position[1] += dy
 	c.FocusCenter[0] += dx
 	c.FocusCenter[1] += dy
+	c.clamp()
 }
 
 // offset is (0,0) when camera position is (ww/2, wh/2)
@@ -0,0 +1,64 @@
+package camera
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	FREE_FLY_SPEED = 240.0 // Pixels per second
+)
+
+// Controller moves a Camera from outside input (debug free-fly, scripted controls etc.)
+// Attached controller is updated every Camera.Update, before auto-focus
+type Controller interface {
+	Update(camera *Camera, dt float64)
+}
+
+type KeyBindings struct {
+	Left  ebiten.Key
+	Right ebiten.Key
+	Up    ebiten.Key
+	Down  ebiten.Key
+}
+
+// IJKL (so it doesn't clash with arrow keys or WASD)
+func DefaultKeyBindings() KeyBindings {
+	return KeyBindings{
+		Left:  ebiten.KeyJ,
+		Right: ebiten.KeyL,
+		Up:    ebiten.KeyI,
+		Down:  ebiten.KeyK,
+	}
+}
+
+// FreeFly moves the camera with keyboard, Speed is in pixels per second
+type FreeFly struct {
+	Keys  KeyBindings
+	Speed float64
+}
+
+func NewFreeFly(speed float64) *FreeFly {
+	return &FreeFly{
+		Keys:  DefaultKeyBindings(),
+		Speed: speed,
+	}
+}
+
+func (f *FreeFly) Update(c *Camera, dt float64) {
+	step := f.Speed * dt
+	if ebiten.IsKeyPressed(f.Keys.Left) {
+		c.MoveBy(-step, 0)
+	}
+
+	if ebiten.IsKeyPressed(f.Keys.Right) {
+		c.MoveBy(step, 0)
+	}
+
+	if ebiten.IsKeyPressed(f.Keys.Up) {
+		c.MoveBy(0, -step)
+	}
+
+	if ebiten.IsKeyPressed(f.Keys.Down) {
+		c.MoveBy(0, step)
+	}
+}
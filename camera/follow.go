@@ -0,0 +1,132 @@
+package camera
+
+import (
+	"math"
+
+	"golang.org/x/image/math/f64"
+)
+
+type FollowMode int
+
+const (
+	FollowSnap      FollowMode = iota // Snaps to the edge of FocusView (deadzone)
+	FollowLerp                        // Eases towards the edge of FocusView
+	FollowSpring                      // Critically damped spring (no overshoot)
+	FollowLookAhead                   // Spring that leads the entity in direction of motion
+)
+
+// Tuning for each FollowMode, all values are per second (frame-rate independent)
+type FollowOptions struct {
+	Mode               FollowMode
+	LerpSpeed          float64 // FollowLerp: Higher is tighter (~ fraction of distance covered per 1/LerpSpeed seconds)
+	SpringFrequency    float64 // FollowSpring/FollowLookAhead: Natural frequency (radians per second)
+	LookAheadTime      float64 // FollowLookAhead: Seconds of entity velocity to lead by
+	LookAheadMax       float64 // FollowLookAhead: Max lead distance in pixels
+	LookAheadSmoothing float64 // FollowLookAhead: How fast lead distance changes (higher is faster)
+}
+
+func DefaultFollowOptions() FollowOptions {
+	return FollowOptions{
+		Mode:               FollowSnap,
+		LerpSpeed:          6.0,
+		SpringFrequency:    8.0,
+		LookAheadTime:      0.3,
+		LookAheadMax:       48.0,
+		LookAheadSmoothing: 4.0,
+	}
+}
+
+// Only changes Mode, rest of FollowOptions are kept
+func (c *Camera) SetFollowMode(mode FollowMode) {
+	c.Follow.Mode = mode
+	c.resetFollow()
+}
+
+func (c *Camera) resetFollow() {
+	c.springVelocity = f64.Vec2{}
+	c.lookAhead = f64.Vec2{}
+	c.trackingEntity = false
+}
+
+// Distance (x,y) is outside FocusView (deadzone), (0, 0) if inside
+func (c *Camera) deadzoneDelta(x, y float64) (dx, dy float64) {
+	lx := c.FocusCenter[0] - c.FocusView[0]/2
+	if x < lx {
+		dx = x - lx
+	}
+	rx := c.FocusCenter[0] + c.FocusView[0]/2
+	if x > rx {
+		dx = x - rx
+	}
+
+	ty := c.FocusCenter[1] - c.FocusView[1]/2
+	if y < ty {
+		dy = y - ty
+	}
+
+	by := c.FocusCenter[1] + c.FocusView[1]/2
+	if y > by {
+		dy = y - by
+	}
+	return dx, dy
+}
+
+// Moves camera towards (x, y) based on Follow.Mode
+func (c *Camera) follow(x, y, dt float64) {
+	if c.Follow.Mode == FollowLookAhead {
+		x, y = c.lead(x, y, dt)
+	}
+
+	dx, dy := c.deadzoneDelta(x, y)
+	c.moveTowards(dx, dy, dt)
+}
+
+// Moves camera by (dx, dy) smoothed based on Follow.Mode
+func (c *Camera) moveTowards(dx, dy, dt float64) {
+	switch c.Follow.Mode {
+	case FollowLerp:
+		t := 1 - math.Exp(-c.Follow.LerpSpeed*dt)
+		c.MoveBy(dx*t, dy*t)
+	case FollowSpring, FollowLookAhead:
+		mx := springStep(dx, &c.springVelocity[0], c.Follow.SpringFrequency, dt)
+		my := springStep(dy, &c.springVelocity[1], c.Follow.SpringFrequency, dt)
+		c.MoveBy(mx, my)
+	default:
+		c.MoveBy(dx, dy)
+	}
+}
+
+// Shifts target (x, y) ahead in direction of entity's motion
+func (c *Camera) lead(x, y, dt float64) (float64, float64) {
+	if !c.trackingEntity || dt <= 0 {
+		c.lastTarget = f64.Vec2{x, y}
+		c.trackingEntity = true
+		return x + c.lookAhead[0], y + c.lookAhead[1]
+	}
+
+	vx, vy := (x-c.lastTarget[0])/dt, (y-c.lastTarget[1])/dt
+	c.lastTarget = f64.Vec2{x, y}
+
+	ax, ay := vx*c.Follow.LookAheadTime, vy*c.Follow.LookAheadTime
+	if length := math.Hypot(ax, ay); length > c.Follow.LookAheadMax && length > 0 {
+		ax, ay = ax*c.Follow.LookAheadMax/length, ay*c.Follow.LookAheadMax/length
+	}
+
+	t := 1 - math.Exp(-c.Follow.LookAheadSmoothing*dt)
+	c.lookAhead[0] += (ax - c.lookAhead[0]) * t
+	c.lookAhead[1] += (ay - c.lookAhead[1]) * t
+
+	return x + c.lookAhead[0], y + c.lookAhead[1]
+}
+
+// Critically damped spring towards distance (returns how much to move this step)
+// Stable for any dt (from Game Programming Gems 4, "Critically Damped Ease-In/Ease-Out Smoothing")
+func springStep(distance float64, velocity *float64, omega, dt float64) float64 {
+	x := omega * dt
+	exp := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+	change := -distance // current - target
+	temp := (*velocity + omega*change) * dt
+	*velocity = (*velocity - omega*temp) * exp
+	newChange := (change + temp) * exp
+	return newChange - change
+}
@@ -0,0 +1,76 @@
+package camera
+
+import (
+	"math"
+	"testing"
+
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
+)
+
+type point struct{ x, y float64 }
+
+func (p point) GetPosition() (float64, float64) {
+	return p.x, p.y
+}
+
+// Entity is 150px right of the FocusView edge, camera follows it for seconds at tps
+// Returns remaining distance between FocusView edge and entity after every tick
+func followDistances(mode FollowMode, tps float64, seconds float64) []float64 {
+	c := New(1000, 1000, 100, 100, 500, 500)
+	c.SetClock(clk.NewFake(1 / tps))
+	c.SetFollowMode(mode)
+	c.FocusOn(point{700, 500})
+
+	distances := make([]float64, int(math.Round(tps*seconds)))
+	for i := range distances {
+		c.Update()
+		distances[i] = 700 - (c.FocusCenter[0] + c.FocusView[0]/2)
+	}
+	return distances
+}
+
+func TestFollowLerpTickRateIndependent(t *testing.T) {
+	// Exponential smoothing, remaining distance after 1s is 150 * e^-LerpSpeed at any tick rate
+	want := 150 * math.Exp(-DefaultFollowOptions().LerpSpeed)
+	for _, tps := range []float64{30, 60, 144} {
+		distances := followDistances(FollowLerp, tps, 1)
+		if got := distances[len(distances)-1]; math.Abs(got-want) > 1e-6 {
+			t.Errorf("%v TPS: distance after 1s = %v, want %v", tps, got, want)
+		}
+	}
+}
+
+func TestFollowSpringConverges(t *testing.T) {
+	results := map[float64]float64{}
+	for _, tps := range []float64{30, 60, 144} {
+		distances := followDistances(FollowSpring, tps, 2)
+		for i, d := range distances {
+			if d < -1e-6 {
+				t.Fatalf("%v TPS: overshoot by %v at tick %d", tps, -d, i)
+			}
+		}
+		results[tps] = distances[len(distances)-1]
+		if results[tps] > 0.5 {
+			t.Errorf("%v TPS: distance after 2s = %v, want < 0.5", tps, results[tps])
+		}
+	}
+
+	// Early on, every tick rate has covered about the same distance
+	// (within 5% of the start distance, springStep approximates exp so it isn't exact like lerp)
+	half := map[float64]float64{}
+	for _, tps := range []float64{30, 60, 144} {
+		distances := followDistances(FollowSpring, tps, 0.25)
+		half[tps] = distances[len(distances)-1]
+	}
+	if math.Abs(half[30]-half[144]) > 0.05*150 {
+		t.Errorf("distance after 0.25s differs between 30 TPS (%v) and 144 TPS (%v)", half[30], half[144])
+	}
+}
+
+func TestFollowSnapIsImmediate(t *testing.T) {
+	for _, tps := range []float64{30, 60, 144} {
+		if d := followDistances(FollowSnap, tps, 1)[0]; d != 0 {
+			t.Errorf("%v TPS: distance after first tick = %v, want 0", tps, d)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package camera
+
+import (
+	"math"
+)
+
+// Entity in a focus group, higher Weight pulls the camera closer to it
+type FocusTarget struct {
+	Entity FocusableEntity
+	Weight float64
+}
+
+type GroupOptions struct {
+	Padding   float64 // Pixels kept between the outermost target and edge of the frame
+	MinScale  float64 // Max zoom out (0.5 = half size)
+	MaxScale  float64 // Max zoom in
+	ZoomSpeed float64 // How fast zoom adjusts (per second, higher is faster)
+}
+
+func DefaultGroupOptions() GroupOptions {
+	return GroupOptions{
+		Padding:   32.0,
+		MinScale:  0.5,
+		MaxScale:  1.0,
+		ZoomSpeed: 4.0,
+	}
+}
+
+// Anything the camera can zoom for group framing (viewport.Viewport)
+type Zoomer interface {
+	GetScale() (scale float64)
+	SetScale(scale float64)
+}
+
+// Set automatically by screen.CustomScreen when both Camera and Viewport are present
+func (c *Camera) SetZoomer(zoomer Zoomer) {
+	c.Zoomer = zoomer
+}
+
+// Centers on weighted centroid of all targets and zooms (through Zoomer) to keep them in frame
+// Replaces FocusedEntity, use FocusOn to go back to single entity
+func (c *Camera) FocusOnGroup(targets ...FocusTarget) {
+	c.Group = targets
+	c.FocusedEntity = nil
+	c.AutoFocus = true
+	c.resetFollow()
+}
+
+func (c *Camera) followGroup(dt float64) {
+	cx, cy, ok := c.groupCentroid()
+	if !ok {
+		return
+	}
+
+	// Center of visible area in World coordinates (with current camera offset)
+	ox, oy := c.Position[0]-c.WorldView[0]/2, c.Position[1]-c.WorldView[1]/2
+	vx := c.ViewArea[0] + c.ViewArea[2]/2 + ox
+	vy := c.ViewArea[1] + c.ViewArea[3]/2 + oy
+	c.moveTowards(cx-vx, cy-vy, dt)
+
+	if c.Zoomer != nil {
+		current := c.Zoomer.GetScale()
+		target := c.groupScale(cx, cy, current)
+		t := 1 - math.Exp(-c.GroupOptions.ZoomSpeed*dt)
+		c.Zoomer.SetScale(current + (target-current)*t)
+	}
+}
+
+func (c *Camera) groupCentroid() (cx, cy float64, ok bool) {
+	totalWeight := 0.0
+	for _, target := range c.Group {
+		if target.Entity == nil || target.Weight <= 0 {
+			continue
+		}
+		x, y := target.Entity.GetPosition()
+		cx += x * target.Weight
+		cy += y * target.Weight
+		totalWeight += target.Weight
+	}
+	if totalWeight == 0 {
+		return 0, 0, false
+	}
+	return cx / totalWeight, cy / totalWeight, true
+}
+
+// Scale at which every target (plus Padding) fits on screen when centered on (cx, cy)
+// ViewArea is already zoomed by current scale, so it is converted back to screen size first
+func (c *Camera) groupScale(cx, cy, current float64) float64 {
+	viewW, viewH := c.ViewArea[2]*current, c.ViewArea[3]*current
+	extentX, extentY := 0.0, 0.0
+	for _, target := range c.Group {
+		if target.Entity == nil {
+			continue
+		}
+		x, y := target.Entity.GetPosition()
+		extentX = math.Max(extentX, math.Abs(x-cx))
+		extentY = math.Max(extentY, math.Abs(y-cy))
+	}
+	frameW := 2 * (extentX + c.GroupOptions.Padding)
+	frameH := 2 * (extentY + c.GroupOptions.Padding)
+
+	scale := c.GroupOptions.MaxScale
+	if frameW > 0 {
+		scale = math.Min(scale, viewW/frameW)
+	}
+	if frameH > 0 {
+		scale = math.Min(scale, viewH/frameH)
+	}
+	return math.Max(scale, c.GroupOptions.MinScale)
+}
@@ -0,0 +1,215 @@
+package camera
+
+import (
+	"golang.org/x/image/math/f64"
+
+	"github.com/shubhamdwivedii/scene-engine/ease"
+)
+
+type Interpolation int
+
+const (
+	InterpLinear     Interpolation = iota // Straight lines between keyframes
+	InterpCatmullRom                      // Smooth curve through every keyframe
+	InterpBezier                          // Cubic Bezier using keyframe In/Out handles
+)
+
+type Keyframe struct {
+	X, Y     float64   // Passed to Mover.MoveTo (Camera Position or Viewport top-left)
+	Scale    float64   // Zoom applied through Zoomer if Path.AnimateScale (0 is treated as 1.0)
+	Rotation float64   // Radians, applied through Rotator if Path.AnimateRotation
+	Duration float64   // Seconds to travel from previous keyframe (ignored for first keyframe)
+	Hold     float64   // Seconds to stay at this keyframe
+	Easing   ease.Func // Easing used to reach this keyframe (Linear if nil)
+	In       f64.Vec2  // InterpBezier: Handle relative to (X,Y) when arriving
+	Out      f64.Vec2  // InterpBezier: Handle relative to (X,Y) when leaving
+}
+
+// Scale and Rotation of keyframes are ignored unless enabled
+// (so a path only moving the camera keeps current zoom and rotation)
+type Path struct {
+	Keyframes       []Keyframe
+	Interpolation   Interpolation
+	ResumeFocus     bool // Camera goes back to FocusOn/FocusOnGroup target after path completes
+	AnimateScale    bool // Keyframe.Scale is applied through Zoomer
+	AnimateRotation bool // Keyframe.Rotation is applied through Rotator
+}
+
+// Anything that can be moved by a path (Camera and viewport.Viewport)
+type Mover interface {
+	MoveTo(x, y float64)
+}
+
+// Anything that can be rotated by a path (viewport.Viewport)
+type Rotator interface {
+	GetAngle() (radians float64)
+	SetAngle(radians float64)
+}
+
+// PathPlayer plays a Path on any Mover (Zoomer and Rotator are optional)
+// Camera.PlayPath uses this, but it can drive a viewport.Viewport directly as well
+type PathPlayer struct {
+	Path       *Path
+	Mover      Mover
+	Zoomer     Zoomer
+	Rotator    Rotator
+	OnComplete func()
+
+	index    int // keyframe being travelled to (or held at)
+	holding  bool
+	elapsed  float64
+	finished bool
+}
+
+func NewPathPlayer(path *Path, mover Mover, zoomer Zoomer, rotator Rotator) *PathPlayer {
+	return &PathPlayer{
+		Path:    path,
+		Mover:   mover,
+		Zoomer:  zoomer,
+		Rotator: rotator,
+		holding: true, // starts at (and holds) first keyframe
+	}
+}
+
+func (p *PathPlayer) Finished() bool {
+	return p.finished
+}
+
+// Advances playback by dt seconds and applies the result, returns true once finished
+func (p *PathPlayer) Update(dt float64) bool {
+	if p.finished {
+		return true
+	}
+	keyframes := p.Path.Keyframes
+	if len(keyframes) == 0 {
+		p.finish()
+		return true
+	}
+
+	p.elapsed += dt
+	for {
+		if p.holding {
+			if p.elapsed < keyframes[p.index].Hold {
+				break
+			}
+			p.elapsed -= keyframes[p.index].Hold
+			p.holding = false
+			p.index++
+			if p.index >= len(keyframes) {
+				last := keyframes[len(keyframes)-1]
+				p.apply(last.X, last.Y, last.Scale, last.Rotation)
+				p.finish()
+				return true
+			}
+		} else {
+			if p.elapsed < keyframes[p.index].Duration {
+				break
+			}
+			p.elapsed -= keyframes[p.index].Duration
+			p.holding = true
+		}
+	}
+
+	p.apply(p.sample())
+	return false
+}
+
+func (p *PathPlayer) finish() {
+	p.finished = true
+	if p.OnComplete != nil {
+		p.OnComplete()
+	}
+}
+
+// Current position, scale & rotation on the path
+func (p *PathPlayer) sample() (x, y, scale, rotation float64) {
+	keyframes := p.Path.Keyframes
+	to := keyframes[p.index]
+	if p.holding {
+		return to.X, to.Y, to.Scale, to.Rotation
+	}
+	from := keyframes[p.index-1]
+	t := ease.Apply(to.Easing, p.elapsed/to.Duration)
+
+	switch p.Path.Interpolation {
+	case InterpCatmullRom:
+		before, after := from, to
+		if p.index-2 >= 0 {
+			before = keyframes[p.index-2]
+		}
+		if p.index+1 < len(keyframes) {
+			after = keyframes[p.index+1]
+		}
+		x = catmullRom(before.X, from.X, to.X, after.X, t)
+		y = catmullRom(before.Y, from.Y, to.Y, after.Y, t)
+	case InterpBezier:
+		x = bezier(from.X, from.X+from.Out[0], to.X+to.In[0], to.X, t)
+		y = bezier(from.Y, from.Y+from.Out[1], to.Y+to.In[1], to.Y, t)
+	default:
+		x = lerp(from.X, to.X, t)
+		y = lerp(from.Y, to.Y, t)
+	}
+	scale = lerp(scaleOrDefault(from.Scale), scaleOrDefault(to.Scale), t)
+	rotation = lerp(from.Rotation, to.Rotation, t)
+	return x, y, scale, rotation
+}
+
+func (p *PathPlayer) apply(x, y, scale, rotation float64) {
+	p.Mover.MoveTo(x, y)
+	if p.Zoomer != nil && p.Path.AnimateScale {
+		p.Zoomer.SetScale(scaleOrDefault(scale))
+	}
+	if p.Rotator != nil && p.Path.AnimateRotation {
+		p.Rotator.SetAngle(rotation)
+	}
+}
+
+// Plays path on this camera (zoom/rotation through Zoomer if it is also a Rotator)
+// Controller and auto-focus are suspended during playback, onComplete can be nil
+func (c *Camera) PlayPath(path *Path, onComplete func()) {
+	rotator, _ := c.Zoomer.(Rotator)
+	player := NewPathPlayer(path, c, c.Zoomer, rotator)
+	player.OnComplete = func() {
+		c.pathPlayer = nil
+		if !path.ResumeFocus {
+			c.AutoFocus = false
+		}
+		c.resetFollow()
+		if onComplete != nil {
+			onComplete()
+		}
+	}
+	c.pathPlayer = player
+}
+
+// Stops path playback without calling onComplete
+func (c *Camera) StopPath() {
+	c.pathPlayer = nil
+}
+
+func (c *Camera) IsPlayingPath() bool {
+	return c.pathPlayer != nil
+}
+
+func scaleOrDefault(scale float64) float64 {
+	if scale == 0 {
+		return 1.0
+	}
+	return scale
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// Uniform Catmull-Rom between p1 and p2
+func catmullRom(p0, p1, p2, p3, t float64) float64 {
+	t2, t3 := t*t, t*t*t
+	return 0.5 * (2*p1 + (p2-p0)*t + (2*p0-5*p1+4*p2-p3)*t2 + (3*p1-p0-3*p2+p3)*t3)
+}
+
+// Cubic Bezier between p0 and p3
+func bezier(p0, p1, p2, p3, t float64) float64 {
+	u := 1 - t
+	return u*u*u*p0 + 3*u*u*t*p1 + 3*u*t*t*p2 + t*t*t*p3
+}
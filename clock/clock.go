@@ -0,0 +1,102 @@
+package clock
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	FALLBACK_TPS = 60
+)
+
+// Clock is read by every time dependent subsystem (shake, camera follow, transitions)
+// Delta is the time (in seconds) covered by the current Update tick
+type Clock interface {
+	Delta() float64
+}
+
+// TickClock follows Ebiten's tick rate, so changing ebiten.SetMaxTPS doesn't change behavior
+type TickClock struct{}
+
+func (c TickClock) Delta() float64 {
+	tps := ebiten.MaxTPS()
+	if tps <= 0 {
+		// Uncapped (synced with FPS) so use measured TPS instead
+		if current := ebiten.CurrentTPS(); current > 0 {
+			return 1 / current
+		}
+		tps = FALLBACK_TPS
+	}
+	return 1 / float64(tps)
+}
+
+// Shared clock used when a subsystem is not given its own clock
+var Default Clock = TickClock{}
+
+// FakeClock returns a fixed Delta, useful for deterministic tests and replays
+type FakeClock struct {
+	Step float64 // Delta returned every tick
+}
+
+func NewFake(step float64) *FakeClock {
+	return &FakeClock{Step: step}
+}
+
+func (c *FakeClock) Delta() float64 {
+	return c.Step
+}
+
+// ScaledClock is game time on top of a Base clock (slow motion, pause and hit-stop)
+// Give it to gameplay subsystems only, UI/Overlay keeps using real time (Default)
+type ScaledClock struct {
+	Base   Clock
+	Scale  float64 // 1.0 is real time (0.5 = half speed)
+	Paused bool
+	frozen int // Remaining hit-stop ticks
+}
+
+func NewScaled(base Clock) *ScaledClock {
+	return &ScaledClock{Base: base, Scale: 1.0}
+}
+
+// Zero while Paused or Frozen
+func (c *ScaledClock) Delta() float64 {
+	if c.Paused || c.frozen > 0 {
+		return 0
+	}
+	return c.Base.Delta() * c.Scale
+}
+
+func (c *ScaledClock) SetScale(scale float64) {
+	c.Scale = scale
+}
+
+func (c *ScaledClock) Pause() {
+	c.Paused = true
+}
+
+func (c *ScaledClock) Resume() {
+	c.Paused = false
+}
+
+// Hit-stop, game time stops for the next n ticks (longer freeze wins if already frozen)
+func (c *ScaledClock) Freeze(ticks int) {
+	if ticks > c.frozen {
+		c.frozen = ticks
+	}
+}
+
+func (c *ScaledClock) Frozen() bool {
+	return c.frozen > 0
+}
+
+// Game time is stopped (Paused, Frozen or zero Scale)
+func (c *ScaledClock) Stopped() bool {
+	return c.Paused || c.frozen > 0 || c.Scale == 0
+}
+
+// Call once at the end of every Update (counts down hit-stop)
+func (c *ScaledClock) Tick() {
+	if c.frozen > 0 && !c.Paused {
+		c.frozen--
+	}
+}
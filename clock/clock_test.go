@@ -0,0 +1,110 @@
+package clock
+
+import (
+	"math"
+	"testing"
+)
+
+// Delta of every tick, Tick is called after each read (like Manager.Update)
+func deltas(c *ScaledClock, ticks int) []float64 {
+	out := make([]float64, ticks)
+	for i := range out {
+		out[i] = c.Delta()
+		c.Tick()
+	}
+	return out
+}
+
+func TestScaledClockFreeze(t *testing.T) {
+	tests := []struct {
+		name   string
+		freeze []int // Freeze calls before ticking
+		zeros  int   // Expected zero-delta ticks
+	}{
+		{"no freeze", nil, 0},
+		{"one tick", []int{1}, 1},
+		{"eight ticks", []int{8}, 8},
+		{"longer freeze wins", []int{3, 6}, 6},
+		{"shorter freeze is ignored", []int{6, 3}, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewScaled(NewFake(1.0 / 60))
+			for _, n := range tt.freeze {
+				c.Freeze(n)
+			}
+			for i, d := range deltas(c, tt.zeros+2) {
+				want := 1.0 / 60
+				if i < tt.zeros {
+					want = 0
+				}
+				if d != want {
+					t.Errorf("tick %d: delta = %v, want %v", i, d, want)
+				}
+			}
+			if c.Frozen() {
+				t.Error("still frozen after freeze ran out")
+			}
+		})
+	}
+}
+
+func TestScaledClockPause(t *testing.T) {
+	c := NewScaled(NewFake(0.1))
+	c.Freeze(2)
+	c.Pause()
+	for i, d := range deltas(c, 5) {
+		if d != 0 {
+			t.Errorf("paused tick %d: delta = %v, want 0", i, d)
+		}
+	}
+	if !c.Stopped() {
+		t.Error("paused clock is not Stopped")
+	}
+
+	// Hit-stop doesn't count down while paused
+	c.Resume()
+	want := []float64{0, 0, 0.1, 0.1}
+	for i, d := range deltas(c, len(want)) {
+		if d != want[i] {
+			t.Errorf("resumed tick %d: delta = %v, want %v", i, d, want[i])
+		}
+	}
+}
+
+func TestScaledClockScale(t *testing.T) {
+	tests := []struct {
+		scale float64
+		want  float64
+	}{
+		{1, 0.1},
+		{0.25, 0.025},
+		{2, 0.2},
+		{0, 0},
+	}
+	for _, tt := range tests {
+		c := NewScaled(NewFake(0.1))
+		c.SetScale(tt.scale)
+		if d := c.Delta(); math.Abs(d-tt.want) > 1e-12 {
+			t.Errorf("scale %v: delta = %v, want %v", tt.scale, d, tt.want)
+		}
+		if c.Stopped() != (tt.scale == 0) {
+			t.Errorf("scale %v: Stopped = %v", tt.scale, c.Stopped())
+		}
+	}
+}
+
+// Same game time passes in one second at any tick rate
+func TestScaledClockTickRateIndependent(t *testing.T) {
+	for _, tps := range []float64{30, 60, 144} {
+		c := NewScaled(NewFake(1 / tps))
+		c.SetScale(0.5)
+		total := 0.0
+		for _, d := range deltas(c, int(tps)) {
+			total += d
+		}
+		if math.Abs(total-0.5) > 1e-9 {
+			t.Errorf("%v TPS: game time after 1s = %v, want 0.5", tps, total)
+		}
+	}
+}
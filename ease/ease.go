@@ -0,0 +1,73 @@
+package ease
+
+import (
+	"math"
+)
+
+// Func maps progress t (0.0 to 1.0) to eased progress (0.0 at t=0, 1.0 at t=1)
+type Func func(t float64) float64
+
+func Linear(t float64) float64 {
+	return t
+}
+
+func InQuad(t float64) float64 {
+	return t * t
+}
+
+func OutQuad(t float64) float64 {
+	return t * (2 - t)
+}
+
+func InOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+func InCubic(t float64) float64 {
+	return t * t * t
+}
+
+func OutCubic(t float64) float64 {
+	t--
+	return t*t*t + 1
+}
+
+func InOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	t = 2*t - 2
+	return 0.5*t*t*t + 1
+}
+
+func InSine(t float64) float64 {
+	return 1 - math.Cos(t*math.Pi/2)
+}
+
+func OutSine(t float64) float64 {
+	return math.Sin(t * math.Pi / 2)
+}
+
+func InOutSine(t float64) float64 {
+	return -(math.Cos(math.Pi*t) - 1) / 2
+}
+
+// Overshoots slightly before settling
+func OutBack(t float64) float64 {
+	const c1 = 1.70158
+	const c3 = c1 + 1
+	t--
+	return 1 + c3*t*t*t + c1*t*t
+}
+
+// Clamps t to [0, 1] and applies fn (Linear if fn is nil)
+func Apply(fn Func, t float64) float64 {
+	t = math.Max(0, math.Min(1, t))
+	if fn == nil {
+		return t
+	}
+	return fn(t)
+}
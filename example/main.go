@@ -4,11 +4,15 @@ import (
 	"image/color"
 	_ "image/png"
 	"log"
+	"math"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	cam "github.com/shubhamdwivedii/scene-engine/camera"
+	"github.com/shubhamdwivedii/scene-engine/ease"
 	gop "github.com/shubhamdwivedii/scene-engine/gopher"
+	mmp "github.com/shubhamdwivedii/scene-engine/minimap"
 	ovr "github.com/shubhamdwivedii/scene-engine/overlay"
 	scr "github.com/shubhamdwivedii/scene-engine/screen"
 	vpt "github.com/shubhamdwivedii/scene-engine/viewport"
@@ -26,6 +30,7 @@ var overlayScreen ovr.Overlay
 var viewport *vpt.Viewport
 var camera *cam.Camera
 var gopher *gop.Gopher
+var minimap *mmp.Minimap
 var crateBox *ebiten.Image
 
 func init() {
@@ -35,14 +40,21 @@ func init() {
 		log.Fatal(err)
 	}
 	gopher = gop.New(WORLD_W/2, WORLD_H/2, 7)
+	gopher.Cull = false // Always on the minimap
 	viewport = vpt.New(VIEW_W, VIEW_H, WORLD_W, WORLD_H, WORLD_W/2, WORLD_H/2)
 	camera = cam.New(WORLD_W, WORLD_H, 120, 120, WORLD_W/3, WORLD_H/2)
 	camera.FocusOn(gopher)
+	camera.SetFollowMode(cam.FollowLookAhead)
+	camera.SetBounds(0, 0, 1000, WORLD_H)             // Platforms go up to 1000px
+	camera.Attach(cam.NewFreeFly(cam.FREE_FLY_SPEED)) // IJKL to move camera
 	gameScreen, err = scr.New(VIEW_W, VIEW_H, WORLD_W, WORLD_H, viewport, camera)
 	if err != nil {
 		log.Fatal(err)
 	}
 	overlayScreen = ovr.New(VIEW_W, VIEW_H)
+	drawParallaxLayers(gameScreen)
+	// Bottom-Right corner, quarter of World size
+	minimap = mmp.New(gameScreen, VIEW_W-WORLD_W/4-4, VIEW_H-WORLD_H/4-4, WORLD_W/4, WORLD_H/4)
 }
 
 func (g *Game) Update() error {
@@ -50,6 +62,14 @@ func (g *Game) Update() error {
 		gameScreen.Shake()
 	}
 
+	// Directional hits (screen is pushed away from the hit)
+	if inpututil.IsKeyJustPressed(ebiten.KeyF) {
+		gameScreen.ShakeImpulse(1, 0, 8, 0.4) // Hit from the left
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyG) {
+		gameScreen.ShakeImpulse(0, -1, 12, 0.6) // Explosion below
+	}
+
 	if ebiten.IsKeyPressed(ebiten.KeyA) {
 		viewport.MoveBy(-1, 0)
 	}
@@ -70,6 +90,12 @@ func (g *Game) Update() error {
 		viewport.ZoomBy(1)
 	}
 
+	// Mouse wheel zooms towards the cursor
+	if _, wheelY := ebiten.Wheel(); wheelY != 0 {
+		cursorX, cursorY := ebiten.CursorPosition()
+		viewport.ZoomAt(float64(cursorX), float64(cursorY), math.Pow(1.1, wheelY))
+	}
+
 	if ebiten.IsKeyPressed(ebiten.KeyR) {
 		viewport.RoatateBy(1)
 	}
@@ -78,6 +104,23 @@ func (g *Game) Update() error {
 		viewport.Reset()
 	}
 
+	// Cutscene: pan along the platforms and come back to the gopher
+	if inpututil.IsKeyJustPressed(ebiten.KeyC) && !camera.IsPlayingPath() {
+		x, y, scale := camera.Position[0], camera.Position[1], viewport.GetScale()
+		camera.PlayPath(&cam.Path{
+			Interpolation: cam.InterpCatmullRom,
+			ResumeFocus:   true,
+			AnimateScale:  true,
+			Keyframes: []cam.Keyframe{
+				{X: x, Y: y, Scale: scale},
+				{X: 500, Y: y - 10, Scale: 1.2, Duration: 2, Hold: 1, Easing: ease.InOutSine},
+				{X: 800, Y: y, Scale: 0.9, Duration: 1.5, Easing: ease.InOutSine},
+				{X: x, Y: y, Scale: scale, Duration: 2, Easing: ease.InOutCubic},
+			},
+		}, nil)
+	}
+
+	minimap.Update()
 	gopher.Update()
 	// Update Camera After FocusEntity has been updated. (Or else you'll see jitter)
 	camera.Update()
@@ -86,9 +129,14 @@ func (g *Game) Update() error {
 }
 
 func (g *Game) Draw(renderScreen *ebiten.Image) {
-	// Draw to game screen first
-	gameScreen.Fill(color.RGBA{202, 244, 244, 0xff})
-	gopher.Draw(gameScreen)
+	// Draw to game screen first (transparent, so parallax layers behind are visible)
+	gameScreen.Fill(color.Transparent)
+
+	// Gopher passes behind/in front of posts depending on its feet (y-sort)
+	gameScreen.QueueYSorted(0, gopher.CY+float64(gopher.H)/2, func() {
+		gopher.Draw(gameScreen)
+	})
+	drawPosts(gameScreen)
 
 	drawPlatforms(gameScreen)
 	gameScreen.Render(renderScreen)
@@ -100,8 +148,37 @@ func (g *Game) Draw(renderScreen *ebiten.Image) {
 	crateBoxOP.ColorM.Scale(1, 1, 1, 0.25)
 	overlayScreen.DrawImage(crateBox, crateBoxOP)
 	overlayScreen.Render(renderScreen)
+
+	minimap.Render(renderScreen)
+}
+
+// Layers are retained, so they are drawn only once
+func drawParallaxLayers(screen scr.Screen) {
+	sky := screen.AddLayer("sky", WORLD_W, WORLD_H, 0, -2) // Fixed
+	sky.Fill(color.RGBA{202, 244, 244, 0xff})
+
+	hills := screen.AddLayer("hills", 1000, WORLD_H, 0.5, -1) // Moves at half speed
+	for i := 0; i < 10; i++ {
+		hills.DrawRect(float64(i*100), 100, 60, 80, true, color.RGBA{150, 200, 150, 0xff})
+	}
+
+	bushes := screen.AddLayer("bushes", 1400, WORLD_H, 1.3, 1) // In front of gameplay
+	for i := 0; i < 14; i++ {
+		bushes.DrawRect(float64(i*100), 230, 30, 20, true, color.RGBA{40, 120, 40, 0xff})
+	}
+}
+
+func drawPosts(screen scr.Screen) {
+	pw, ph := 12.0, 60.0
+	for i := 0; i < 8; i++ {
+		px, py := float64(60+i*120), 90.0
+		screen.QueueYSorted(0, py+ph, func() {
+			screen.DrawRect(px, py, pw, ph, true, color.RGBA{120, 80, 40, 255})
+		})
+	}
 }
 
+// Not culled with IsVisible, so off-screen platforms still show up on the minimap
 func drawPlatforms(screen scr.Screen) {
 	pw := 40.0
 	ph := 20.0
@@ -120,6 +197,9 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeigh
 func main() {
 	ebiten.SetWindowSize(640, 480)
 	gameScreen.SetShakeIntensity(7.5)
+	shakeProfile := gameScreen.GetShakeProfile()
+	shakeProfile.MaxAngle = 0.05 // radians
+	gameScreen.SetShakeProfile(shakeProfile)
 	gameScreen.SetDebug(true)
 	gameScreen.GetViewport().SetMargin(10)
 	// viewport.AllowOutOfBounds = true
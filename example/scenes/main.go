@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	_ "image/png"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
+	"github.com/shubhamdwivedii/scene-engine/ease"
+	gop "github.com/shubhamdwivedii/scene-engine/gopher"
+	"github.com/shubhamdwivedii/scene-engine/hud"
+	ovr "github.com/shubhamdwivedii/scene-engine/overlay"
+	"github.com/shubhamdwivedii/scene-engine/scene"
+)
+
+const (
+	WORLD_W, WORLD_H = 360, 280
+	VIEW_W, VIEW_H   = 320, 240
+)
+
+// Menu: Static screen (no Viewport, no Camera)
+type MenuScene struct {
+	*scene.Base
+}
+
+func NewMenu() *MenuScene {
+	base, err := scene.NewBase(scene.Options{
+		ScreenWidth: VIEW_W, ScreenHeight: VIEW_H,
+		WorldWidth: VIEW_W, WorldHeight: VIEW_H,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return &MenuScene{Base: base}
+}
+
+func (s *MenuScene) Update() error {
+	if inpututil.IsKeyJustPressed(ebiten.KeyEnter) {
+		iris := ovr.NewTransition(ovr.Iris, VIEW_W, VIEW_H, 0.8)
+		iris.Easing = ease.InOutCubic
+		s.Manager.ReplaceWith(NewLevel(), iris)
+	}
+	return s.Base.Update()
+}
+
+func (s *MenuScene) Draw(screen *ebiten.Image) {
+	s.Screen.Fill(color.RGBA{40, 40, 80, 255})
+	s.Screen.DebugPrintAt("SCENE ENGINE\n\nPress Enter to start", 100, 100)
+	s.Base.Draw(screen)
+}
+
+// Level: World bigger than screen, Camera follows the gopher
+type LevelScene struct {
+	*scene.Base
+	gopher    *gop.Gopher
+	hud       *hud.HUD
+	timeLabel *hud.Label
+}
+
+func NewLevel() *LevelScene {
+	base, err := scene.NewBase(scene.Options{
+		ScreenWidth: VIEW_W, ScreenHeight: VIEW_H,
+		WorldWidth: WORLD_W, WorldHeight: WORLD_H,
+		Camera: true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	level := &LevelScene{Base: base, gopher: gop.New(WORLD_W/2, WORLD_H/2, 7)}
+	level.Camera.FocusOn(level.gopher)
+
+	// HUD is anchored to the Overlay (no hand-computed coordinates)
+	level.hud = hud.New(level.Overlay)
+	level.hud.SafeArea = hud.Margins{Top: 4, Right: 4, Bottom: 4, Left: 4}
+	level.timeLabel = hud.NewLabel("")
+	level.hud.Add(hud.NewPanel(hud.NewVStack(0, hud.NewLabel("LEVEL 1"), level.timeLabel), 4, color.RGBA{0, 0, 0, 128}), hud.TopLeft, hud.Offset{})
+	lives := hud.NewHStack(2)
+	for i := 0; i < 3; i++ {
+		lives.Add(hud.NewImage(level.gopher.Img, 0.15))
+	}
+	level.hud.Add(lives, hud.TopRight, hud.Offset{})
+	level.hud.Add(hud.NewLabel("T: slow-mo  X: hit-stop  P: pause"), hud.Bottom, hud.Offset{PercentY: -0.02})
+	return level
+}
+
+func (s *LevelScene) Enter(manager *scene.Manager) {
+	s.Base.Enter(manager)
+	s.gopher.SetClock(manager.Clock)
+}
+
+func (s *LevelScene) Update() error {
+	// T: slow motion, X: hit-stop (game freezes for a few ticks)
+	if inpututil.IsKeyJustPressed(ebiten.KeyT) {
+		if s.Manager.Clock.Scale == 1 {
+			s.Manager.Clock.SetScale(0.25)
+		} else {
+			s.Manager.Clock.SetScale(1)
+		}
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyX) {
+		s.Manager.Clock.Freeze(8)
+		s.Screen.AddTrauma(0.6)
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
+		s.Manager.PushWith(NewPause(), ovr.NewTransition(ovr.Crossfade, VIEW_W, VIEW_H, 0.2))
+		return nil
+	}
+	if inpututil.IsKeyJustPressed(ebiten.KeyEscape) {
+		s.Manager.ReplaceWith(NewMenu(), ovr.NewTransition(ovr.Fade, VIEW_W, VIEW_H, 1.0))
+		return nil
+	}
+	if ebiten.IsKeyPressed(ebiten.KeySpace) {
+		s.Screen.Shake()
+	}
+	s.gopher.Update()
+	return s.Base.Update()
+}
+
+func (s *LevelScene) Draw(screen *ebiten.Image) {
+	s.Screen.Fill(color.RGBA{202, 244, 244, 0xff})
+	s.gopher.Draw(s.Screen)
+	for i := 0; i < 20; i++ {
+		s.Screen.DrawRect(float64(i*50), 160, 40, 20, true, color.RGBA{255, 0, 0, 255})
+	}
+	s.timeLabel.Text = fmt.Sprintf("Time x%.2f", s.Manager.Clock.Scale)
+	s.hud.Draw()
+	s.Base.Draw(screen)
+}
+
+// Pause: Drawn over the level (Transparent), game clock is paused but Pause scene animates on real time
+type PauseScene struct {
+	*scene.Base
+	elapsed float64
+}
+
+func NewPause() *PauseScene {
+	base, err := scene.NewBase(scene.Options{
+		ScreenWidth: VIEW_W, ScreenHeight: VIEW_H,
+		WorldWidth: VIEW_W, WorldHeight: VIEW_H,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return &PauseScene{Base: base}
+}
+
+func (s *PauseScene) Transparent() bool {
+	return true
+}
+
+func (s *PauseScene) Enter(manager *scene.Manager) {
+	s.Base.Enter(manager)
+	manager.Clock.Pause()
+}
+
+func (s *PauseScene) Exit() {
+	s.Manager.Clock.Resume()
+}
+
+func (s *PauseScene) Update() error {
+	s.elapsed += clk.Default.Delta()
+	if inpututil.IsKeyJustPressed(ebiten.KeyP) {
+		s.Manager.PopWith(ovr.NewTransition(ovr.Dissolve, VIEW_W, VIEW_H, 0.4))
+	}
+	return nil
+}
+
+func (s *PauseScene) Draw(screen *ebiten.Image) {
+	ebitenutil.DrawRect(screen, 0, 0, VIEW_W, VIEW_H, color.RGBA{0, 0, 0, 128})
+	// Blinks at real time while game time is paused
+	if int(s.elapsed*2)%2 == 0 {
+		ebitenutil.DebugPrintAt(screen, "PAUSED (P to resume)", 100, 110)
+	}
+}
+
+func main() {
+	ebiten.SetWindowSize(640, 480)
+	if err := ebiten.RunGame(scene.NewManager(NewMenu())); err != nil {
+		log.Fatal(err)
+	}
+}
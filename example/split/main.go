@@ -0,0 +1,107 @@
+package main
+
+import (
+	"image/color"
+	_ "image/png"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	cam "github.com/shubhamdwivedii/scene-engine/camera"
+	gop "github.com/shubhamdwivedii/scene-engine/gopher"
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
+	vpt "github.com/shubhamdwivedii/scene-engine/viewport"
+)
+
+type Game struct{}
+
+const (
+	WORLD_W, WORLD_H = 1000, 280
+	VIEW_W, VIEW_H   = 320, 240
+)
+
+var splitScreen *scr.SplitScreen
+var followView, freeView *scr.View
+var gopher *gop.Gopher
+
+func init() {
+	var err error
+	gopher = gop.New(WORLD_W/5, WORLD_H/2, 7)
+	splitScreen = scr.NewSplit(VIEW_W, VIEW_H, WORLD_W, WORLD_H)
+
+	// Left: Follows the gopher
+	followCamera := cam.New(WORLD_W, WORLD_H, 60, 120, WORLD_W/5, WORLD_H/2)
+	followCamera.FocusOn(gopher)
+	followCamera.SetFollowMode(cam.FollowSpring)
+	followViewport := vpt.New(VIEW_W/2, VIEW_H, WORLD_W, WORLD_H, WORLD_W/5, WORLD_H/2)
+	followView, err = splitScreen.AddView(followViewport, followCamera, scr.Rect{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Right: Free-Fly camera (IJKL)
+	freeCamera := cam.New(WORLD_W, WORLD_H, 60, 120, WORLD_W/2, WORLD_H/2)
+	freeCamera.Attach(cam.NewFreeFly(cam.FREE_FLY_SPEED))
+	freeViewport := vpt.New(VIEW_W/2, VIEW_H, WORLD_W, WORLD_H, WORLD_W/2, WORLD_H/2)
+	freeView, err = splitScreen.AddView(freeViewport, freeCamera, scr.Rect{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	splitScreen.SetLayout(scr.LayoutHorizontal(2))
+}
+
+func (g *Game) Update() error {
+	// Shake only the left view
+	if ebiten.IsKeyPressed(ebiten.KeySpace) {
+		followView.Shake()
+	}
+
+	// 1: Side by side, 2: Stacked, 3: Picture-In-Picture
+	if inpututil.IsKeyJustPressed(ebiten.Key1) {
+		splitScreen.SetLayout(scr.LayoutHorizontal(2))
+	}
+	if inpututil.IsKeyJustPressed(ebiten.Key2) {
+		splitScreen.SetLayout(scr.LayoutVertical(2))
+	}
+	if inpututil.IsKeyJustPressed(ebiten.Key3) {
+		splitScreen.SetLayout(scr.LayoutPictureInPicture(0.35, 0.02))
+	}
+
+	gopher.Update()
+	// Update Cameras After FocusEntity has been updated. (Or else you'll see jitter)
+	followView.Camera.Update()
+	freeView.Camera.Update()
+	splitScreen.Update()
+	return nil
+}
+
+func (g *Game) Draw(renderScreen *ebiten.Image) {
+	// World is drawn once, every view renders the same image
+	splitScreen.Fill(color.RGBA{202, 244, 244, 0xff})
+	gopher.Draw(splitScreen)
+	drawPlatforms(splitScreen)
+	splitScreen.Render(renderScreen)
+}
+
+func drawPlatforms(screen scr.Canvas) {
+	pw := 40.0
+	ph := 20.0
+	gap := 50
+	py := 160.0
+	for i := 0; i < 20; i++ {
+		screen.DrawRect(float64(i*gap), py, pw, ph, true, color.RGBA{255, 0, 0, 255})
+	}
+}
+
+func (g *Game) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
+	return VIEW_W, VIEW_H
+}
+
+func main() {
+	ebiten.SetWindowSize(640, 480)
+	splitScreen.SetDebug(true)
+	if err := ebiten.RunGame(&Game{}); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -2,13 +2,16 @@ module github.com/shubhamdwivedii/scene-engine
 
 go 1.17
 
+require (
+	github.com/hajimehoshi/ebiten/v2 v2.2.4
+	github.com/peterhellberg/gfx v0.0.0-20210905153911-4a6ef1535e02
+	golang.org/x/image v0.0.0-20210628002857-a66eb6448b8d
+)
+
 require (
 	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20210727001814-0db043d8d5be // indirect
-	github.com/hajimehoshi/ebiten/v2 v2.2.4 // indirect
 	github.com/jezek/xgb v0.0.0-20210312150743-0e0f116e1240 // indirect
-	github.com/peterhellberg/gfx v0.0.0-20210905153911-4a6ef1535e02 // indirect
 	golang.org/x/exp v0.0.0-20190731235908-ec7cb31e5a56 // indirect
-	golang.org/x/image v0.0.0-20210628002857-a66eb6448b8d // indirect
 	golang.org/x/mobile v0.0.0-20210902104108-5d9a33257ab5 // indirect
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c // indirect
 	golang.org/x/sys v0.0.0-20210917161153-d61c044b1678 // indirect
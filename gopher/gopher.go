@@ -5,25 +5,31 @@ import (
 	"log"
 
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 
+	"github.com/shubhamdwivedii/scene-engine/anim"
+	ast "github.com/shubhamdwivedii/scene-engine/asset"
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
 	scr "github.com/shubhamdwivedii/scene-engine/screen"
 )
 
 type Gopher struct {
-	Img *ebiten.Image
-	X   float64
-	Y   float64
-	CX  float64
-	CY  float64
-	W   int
-	H   int
-	V   float64
-	OP  *ebiten.DrawImageOptions
+	Img   *ebiten.Image
+	X     float64
+	Y     float64
+	CX    float64
+	CY    float64
+	W     int
+	H     int
+	V     float64 // Pixels per tick (at clock.FALLBACK_TPS)
+	OP    *ebiten.DrawImageOptions
+	Anim  *anim.Player
+	Clock clk.Clock
+	Cull  bool // Skip drawing when off-screen (culled gopher doesn't show up on a minimap)
 }
 
 func New(cx, cy, v float64) *Gopher {
-	img, _, err := ebitenutil.NewImageFromFile("./assets/gopher.png")
+	// Cached, so every gopher shares one texture
+	img, err := ast.LoadImage("./assets/gopher.png")
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -32,15 +38,18 @@ func New(cx, cy, v float64) *Gopher {
 	x, y := cx-float64(w/2), cy-float64(h/2)
 
 	return &Gopher{
-		Img: img,
-		X:   x,
-		Y:   y,
-		CX:  cx,
-		CY:  cy,
-		W:   w,
-		H:   h,
-		V:   v,
-		OP:  &ebiten.DrawImageOptions{},
+		Img:   img,
+		X:     x,
+		Y:     y,
+		CX:    cx,
+		CY:    cy,
+		W:     w,
+		H:     h,
+		V:     v,
+		OP:    &ebiten.DrawImageOptions{},
+		Anim:  anim.NewPlayer(anim.NewClip("idle", anim.Loop, 0, img)),
+		Clock: clk.Default,
+		Cull:  true,
 	}
 }
 
@@ -48,32 +57,46 @@ func (g *Gopher) GetPosition() (float64, float64) {
 	return g.CX, g.CY
 }
 
+// Movement and animation follow this clock (slows down/stops with a ScaledClock)
+func (g *Gopher) SetClock(clock clk.Clock) {
+	g.Clock = clock
+	g.Anim.SetClock(clock)
+}
+
 func (g *Gopher) Update() error {
+	v := g.V * g.Clock.Delta() * clk.FALLBACK_TPS
+
 	if ebiten.IsKeyPressed(ebiten.KeyArrowLeft) {
-		g.X -= g.V
-		g.CX -= g.V
+		g.X -= v
+		g.CX -= v
+		g.Anim.FlipX = true
 	}
 
 	if ebiten.IsKeyPressed(ebiten.KeyArrowRight) {
-		g.X += g.V
-		g.CX += g.V
+		g.X += v
+		g.CX += v
+		g.Anim.FlipX = false
 	}
 
 	if ebiten.IsKeyPressed(ebiten.KeyArrowDown) {
-		g.Y += g.V
-		g.CY += g.V
+		g.Y += v
+		g.CY += v
 	}
 
 	if ebiten.IsKeyPressed(ebiten.KeyArrowUp) {
-		g.Y -= g.V
-		g.CY -= g.V
+		g.Y -= v
+		g.CY -= v
 	}
-	return nil
+	return g.Anim.Update()
 }
 
-func (g *Gopher) Draw(gameScreen scr.Screen) {
+func (g *Gopher) Draw(gameScreen scr.Canvas) {
+	// Skip draw calls when off-screen
+	if culler, ok := gameScreen.(scr.Culler); ok && g.Cull && !culler.IsVisible(g.X, g.Y, float64(g.W), float64(g.H)) {
+		return
+	}
 	g.OP.GeoM.Reset()
 	g.OP.GeoM.Translate(g.X, g.Y)
 	gameScreen.DrawRect(g.X, g.Y, float64(g.W), float64(g.H), false, color.RGBA{255, 0, 0, 64})
-	gameScreen.DrawImage(g.Img, g.OP)
+	g.Anim.Draw(gameScreen, g.OP)
 }
@@ -0,0 +1,149 @@
+package hud
+
+import (
+	"math"
+
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
+)
+
+// Cross axis alignment of children in a stack (or cell alignment in a Grid)
+type Align int
+
+const (
+	AlignStart Align = iota
+	AlignCenter
+	AlignEnd
+)
+
+func (a Align) factor() float64 {
+	return float64(a) / 2
+}
+
+// Children left to right
+type HStack struct {
+	Children []Widget
+	Spacing  float64
+	Align    Align // Vertical alignment
+}
+
+func NewHStack(spacing float64, children ...Widget) *HStack {
+	return &HStack{Children: children, Spacing: spacing}
+}
+
+func (s *HStack) Add(child Widget) {
+	s.Children = append(s.Children, child)
+}
+
+func (s *HStack) Size() (float64, float64) {
+	var width, height float64
+	for i, child := range s.Children {
+		w, h := child.Size()
+		if i > 0 {
+			width += s.Spacing
+		}
+		width += w
+		height = math.Max(height, h)
+	}
+	return width, height
+}
+
+func (s *HStack) Draw(canvas scr.Canvas, x, y float64) {
+	_, height := s.Size()
+	for _, child := range s.Children {
+		w, h := child.Size()
+		child.Draw(canvas, x, y+(height-h)*s.Align.factor())
+		x += w + s.Spacing
+	}
+}
+
+// Children top to bottom
+type VStack struct {
+	Children []Widget
+	Spacing  float64
+	Align    Align // Horizontal alignment
+}
+
+func NewVStack(spacing float64, children ...Widget) *VStack {
+	return &VStack{Children: children, Spacing: spacing}
+}
+
+func (s *VStack) Add(child Widget) {
+	s.Children = append(s.Children, child)
+}
+
+func (s *VStack) Size() (float64, float64) {
+	var width, height float64
+	for i, child := range s.Children {
+		w, h := child.Size()
+		if i > 0 {
+			height += s.Spacing
+		}
+		height += h
+		width = math.Max(width, w)
+	}
+	return width, height
+}
+
+func (s *VStack) Draw(canvas scr.Canvas, x, y float64) {
+	width, _ := s.Size()
+	for _, child := range s.Children {
+		w, h := child.Size()
+		child.Draw(canvas, x+(width-w)*s.Align.factor(), y)
+		y += h + s.Spacing
+	}
+}
+
+// Children fill rows of Columns cells, every cell is the size of the largest child
+type Grid struct {
+	Children []Widget
+	Columns  int
+	Spacing  float64
+	Align    Align // Alignment of a child inside its cell (both axes)
+}
+
+func NewGrid(columns int, spacing float64, children ...Widget) *Grid {
+	return &Grid{Children: children, Columns: columns, Spacing: spacing}
+}
+
+func (g *Grid) Add(child Widget) {
+	g.Children = append(g.Children, child)
+}
+
+func (g *Grid) columns() int {
+	if g.Columns < 1 {
+		return 1
+	}
+	return g.Columns
+}
+
+func (g *Grid) cellSize() (cellW, cellH float64) {
+	for _, child := range g.Children {
+		w, h := child.Size()
+		cellW, cellH = math.Max(cellW, w), math.Max(cellH, h)
+	}
+	return cellW, cellH
+}
+
+func (g *Grid) Size() (float64, float64) {
+	if len(g.Children) == 0 {
+		return 0, 0
+	}
+	cols := g.columns()
+	if len(g.Children) < cols {
+		cols = len(g.Children)
+	}
+	rows := (len(g.Children) + g.columns() - 1) / g.columns()
+	cellW, cellH := g.cellSize()
+	return float64(cols)*cellW + float64(cols-1)*g.Spacing, float64(rows)*cellH + float64(rows-1)*g.Spacing
+}
+
+func (g *Grid) Draw(canvas scr.Canvas, x, y float64) {
+	cellW, cellH := g.cellSize()
+	for i, child := range g.Children {
+		col, row := i%g.columns(), i/g.columns()
+		w, h := child.Size()
+		cx := x + float64(col)*(cellW+g.Spacing) + (cellW-w)*g.Align.factor()
+		cy := y + float64(row)*(cellH+g.Spacing) + (cellH-h)*g.Align.factor()
+		child.Draw(canvas, cx, cy)
+	}
+}
@@ -0,0 +1,119 @@
+package hud
+
+import (
+	ovr "github.com/shubhamdwivedii/scene-engine/overlay"
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
+)
+
+type Anchor int
+
+const (
+	TopLeft Anchor = iota
+	Top
+	TopRight
+	Left
+	Center
+	Right
+	BottomLeft
+	Bottom
+	BottomRight
+)
+
+// Fraction of free space placed before the widget (0 = start, 0.5 = middle, 1 = end)
+func (a Anchor) factors() (fx, fy float64) {
+	return float64(a%3) / 2, float64(a/3) / 2
+}
+
+// Offset from anchored position, Percent is fraction of parent area (0.1 = 10%)
+// Positive is right/down for every Anchor
+type Offset struct {
+	X, Y               float64
+	PercentX, PercentY float64
+}
+
+// Area of the Overlay in pixels
+type Rect struct {
+	X, Y, W, H float64
+}
+
+// Space kept free at the edges of the Overlay (notches, TV overscan)
+type Margins struct {
+	Top, Right, Bottom, Left float64
+}
+
+// Widgets measure themselves, containers and HUD decide where they go
+type Widget interface {
+	Size() (width, height float64)
+	Draw(canvas scr.Canvas, x, y float64)
+}
+
+// Top level Widget anchored inside HUD's safe area
+type Element struct {
+	Widget Widget
+	Anchor Anchor
+	Offset Offset
+	Hidden bool
+}
+
+// HUD lays out Elements on an Overlay, positions are recomputed every Draw
+// so changing Overlay size (or widget contents) never needs hand-computed coordinates
+type HUD struct {
+	Overlay  ovr.Overlay
+	SafeArea Margins
+	Elements []*Element
+}
+
+func New(overlay ovr.Overlay) *HUD {
+	return &HUD{Overlay: overlay}
+}
+
+func (h *HUD) Add(widget Widget, anchor Anchor, offset Offset) *Element {
+	element := &Element{Widget: widget, Anchor: anchor, Offset: offset}
+	h.Elements = append(h.Elements, element)
+	return element
+}
+
+func (h *HUD) Remove(element *Element) {
+	for i, e := range h.Elements {
+		if e == element {
+			h.Elements = append(h.Elements[:i], h.Elements[i+1:]...)
+			return
+		}
+	}
+}
+
+// Overlay area minus SafeArea
+func (h *HUD) Bounds() Rect {
+	w, ht := h.Overlay.Size()
+	return Rect{
+		X: h.SafeArea.Left,
+		Y: h.SafeArea.Top,
+		W: float64(w) - h.SafeArea.Left - h.SafeArea.Right,
+		H: float64(ht) - h.SafeArea.Top - h.SafeArea.Bottom,
+	}
+}
+
+// Top-left corner of element inside the safe area
+func (h *HUD) Position(element *Element) (x, y float64) {
+	w, ht := element.Widget.Size()
+	return Place(h.Bounds(), element.Anchor, element.Offset, w, ht)
+}
+
+// Draws every visible Element on the Overlay (call before Overlay.Render)
+func (h *HUD) Draw() {
+	for _, element := range h.Elements {
+		if element.Hidden {
+			continue
+		}
+		x, y := h.Position(element)
+		element.Widget.Draw(h.Overlay, x, y)
+	}
+}
+
+// Top-left corner of a width x height box anchored in area
+func Place(area Rect, anchor Anchor, offset Offset, width, height float64) (x, y float64) {
+	fx, fy := anchor.factors()
+	x = area.X + (area.W-width)*fx + offset.X + offset.PercentX*area.W
+	y = area.Y + (area.H-height)*fy + offset.Y + offset.PercentY*area.H
+	return x, y
+}
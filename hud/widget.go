@@ -0,0 +1,131 @@
+package hud
+
+import (
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
+)
+
+const (
+	DEBUG_GLYPH_W = 6 // ebitenutil.DebugPrint glyph size
+	DEBUG_GLYPH_H = 16
+)
+
+// Text, uses ebitenutil debug font if Face is nil (Color is ignored for debug font)
+type Label struct {
+	Text  string
+	Face  font.Face
+	Color color.Color
+}
+
+func NewLabel(txt string) *Label {
+	return &Label{Text: txt, Color: color.White}
+}
+
+func (l *Label) Size() (float64, float64) {
+	if l.Face == nil {
+		lines := strings.Split(l.Text, "\n")
+		longest := 0
+		for _, line := range lines {
+			if len(line) > longest {
+				longest = len(line)
+			}
+		}
+		return float64(longest * DEBUG_GLYPH_W), float64(len(lines) * DEBUG_GLYPH_H)
+	}
+	bounds := text.BoundString(l.Face, l.Text)
+	return float64(bounds.Dx()), float64(bounds.Dy())
+}
+
+func (l *Label) Draw(canvas scr.Canvas, x, y float64) {
+	if l.Face == nil {
+		canvas.DebugPrintAt(l.Text, int(x), int(y))
+		return
+	}
+	// text.Draw takes the baseline (dot), so shift by the top of the bounds
+	bounds := text.BoundString(l.Face, l.Text)
+	canvas.DrawText(l.Text, l.Face, int(x)-bounds.Min.X, int(y)-bounds.Min.Y, l.Color)
+}
+
+// Image (icon), Scale 1.0 if 0
+type Image struct {
+	Image *ebiten.Image
+	Scale float64
+}
+
+func NewImage(img *ebiten.Image, scale float64) *Image {
+	return &Image{Image: img, Scale: scale}
+}
+
+func (i *Image) scale() float64 {
+	if i.Scale == 0 {
+		return 1
+	}
+	return i.Scale
+}
+
+func (i *Image) Size() (float64, float64) {
+	b := i.Image.Bounds()
+	return float64(b.Dx()) * i.scale(), float64(b.Dy()) * i.scale()
+}
+
+func (i *Image) Draw(canvas scr.Canvas, x, y float64) {
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(i.scale(), i.scale())
+	op.GeoM.Translate(x, y)
+	canvas.DrawImage(i.Image, op)
+}
+
+// Background box around an optional Child (Width/Height are minimum size)
+type Panel struct {
+	Child   Widget
+	Width   float64
+	Height  float64
+	Padding float64
+	Color   color.Color // Fill, none if nil
+	Border  color.Color // Outline, none if nil
+}
+
+func NewPanel(child Widget, padding float64, clr color.Color) *Panel {
+	return &Panel{Child: child, Padding: padding, Color: clr}
+}
+
+func (p *Panel) Size() (float64, float64) {
+	var w, h float64
+	if p.Child != nil {
+		w, h = p.Child.Size()
+	}
+	return math.Max(p.Width, w+2*p.Padding), math.Max(p.Height, h+2*p.Padding)
+}
+
+func (p *Panel) Draw(canvas scr.Canvas, x, y float64) {
+	w, h := p.Size()
+	if p.Color != nil {
+		canvas.DrawRect(x, y, w, h, true, p.Color)
+	}
+	if p.Border != nil {
+		canvas.DrawRect(x, y, w, h, false, p.Border)
+	}
+	if p.Child != nil {
+		cw, ch := p.Child.Size()
+		// Child is centered if Panel is bigger than Child + Padding
+		p.Child.Draw(canvas, x+(w-cw)/2, y+(h-ch)/2)
+	}
+}
+
+// Empty space in stacks and grids
+type Spacer struct {
+	Width, Height float64
+}
+
+func (s Spacer) Size() (float64, float64) {
+	return s.Width, s.Height
+}
+
+func (s Spacer) Draw(canvas scr.Canvas, x, y float64) {}
@@ -0,0 +1,135 @@
+package minimap
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
+)
+
+// Minimap renders the whole World image of a Screen (scaled down) into a rectangle of the render target
+// It reuses the Screen image, so Render it after the Screen has been drawn for the frame
+// Draws skipped with Screen.IsVisible (culling) are not in that image, so they don't appear on the Minimap
+type Minimap struct {
+	Screen       scr.Screen
+	X            int // TopLeft of Minimap on render target
+	Y            int
+	Width        int
+	Height       int
+	Border       color.Color
+	FrustumColor color.Color // Area visible through the Viewport
+	FocusColor   color.Color // Camera FocusView
+	ClickToMove  bool        // Left click (or drag) moves Viewport to clicked point
+	DrawOP       *ebiten.DrawImageOptions
+}
+
+func New(screen scr.Screen, x, y, width, height int) *Minimap {
+	return &Minimap{
+		Screen:       screen,
+		X:            x,
+		Y:            y,
+		Width:        width,
+		Height:       height,
+		Border:       color.RGBA{255, 255, 255, 255},
+		FrustumColor: color.RGBA{255, 0, 0, 255},
+		FocusColor:   color.RGBA{0, 0, 255, 255},
+		ClickToMove:  true,
+		DrawOP:       &ebiten.DrawImageOptions{},
+	}
+}
+
+// Scale from World image to Minimap
+func (m *Minimap) scale() (float64, float64) {
+	bounds := m.Screen.GetImage().Bounds()
+	return float64(m.Width) / float64(bounds.Dx()), float64(m.Height) / float64(bounds.Dy())
+}
+
+// Converts World image coordinates to render target coordinates
+func (m *Minimap) toMinimap(x, y float64) (float64, float64) {
+	sx, sy := m.scale()
+	return float64(m.X) + x*sx, float64(m.Y) + y*sy
+}
+
+// Converts render target coordinates to World image coordinates
+func (m *Minimap) toWorld(x, y float64) (float64, float64) {
+	sx, sy := m.scale()
+	return (x - float64(m.X)) / sx, (y - float64(m.Y)) / sy
+}
+
+func (m *Minimap) Contains(x, y int) bool {
+	return x >= m.X && x < m.X+m.Width && y >= m.Y && y < m.Y+m.Height
+}
+
+// Handles click-to-move (ebiten.CursorPosition is in render target coordinates)
+func (m *Minimap) Update() error {
+	viewport := m.Screen.GetViewport()
+	if !m.ClickToMove || viewport == nil || !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		return nil
+	}
+	cursorX, cursorY := ebiten.CursorPosition()
+	if !m.Contains(cursorX, cursorY) {
+		return nil
+	}
+	x, y := m.toWorld(float64(cursorX), float64(cursorY))
+	// Viewport.Position is TopLeft, center it on clicked point
+	viewport.MoveTo(x-viewport.Dimensions[0]/2, y-viewport.Dimensions[1]/2)
+	return nil
+}
+
+func (m *Minimap) Render(screen *ebiten.Image) {
+	sx, sy := m.scale()
+	m.DrawOP.GeoM.Reset()
+	m.DrawOP.GeoM.Scale(sx, sy)
+	m.DrawOP.GeoM.Translate(float64(m.X), float64(m.Y))
+	screen.DrawImage(m.Screen.GetImage(), m.DrawOP)
+
+	m.drawFrustum(screen)
+	m.drawFocusArea(screen)
+
+	x1, y1 := float64(m.X), float64(m.Y)
+	x2, y2 := x1+float64(m.Width), y1+float64(m.Height)
+	ebitenutil.DrawLine(screen, x1, y1, x2, y1, m.Border)
+	ebitenutil.DrawLine(screen, x1+1, y1, x1+1, y2, m.Border)
+	ebitenutil.DrawLine(screen, x2, y1, x2, y2, m.Border)
+	ebitenutil.DrawLine(screen, x1, y2-1, x2, y2-1, m.Border)
+}
+
+// Visible area (zoomed/rotated quad of the Viewport, or un-padded area if there is no Viewport)
+func (m *Minimap) drawFrustum(screen *ebiten.Image) {
+	var quad [4][2]float64
+	if viewport := m.Screen.GetViewport(); viewport != nil {
+		for i, corner := range viewport.VisibleQuad() {
+			quad[i] = [2]float64{corner[0], corner[1]}
+		}
+	} else {
+		bounds := m.Screen.GetImage().Bounds()
+		x1, y1 := float64(scr.AUTO_PADDING), float64(scr.AUTO_PADDING)
+		x2, y2 := float64(bounds.Dx()-scr.AUTO_PADDING), float64(bounds.Dy()-scr.AUTO_PADDING)
+		quad = [4][2]float64{{x1, y1}, {x2, y1}, {x2, y2}, {x1, y2}}
+	}
+	m.drawQuad(screen, quad, m.FrustumColor)
+}
+
+// Camera FocusView, Camera offset is applied same as CustomScreen.DrawLine
+func (m *Minimap) drawFocusArea(screen *ebiten.Image) {
+	camera := m.Screen.GetCamera()
+	if camera == nil {
+		return
+	}
+	offx, offy := m.Screen.GetOffsets()
+	x1 := camera.FocusCenter[0] - camera.FocusView[0]/2 + offx
+	y1 := camera.FocusCenter[1] - camera.FocusView[1]/2 + offy
+	x2, y2 := x1+camera.FocusView[0], y1+camera.FocusView[1]
+	m.drawQuad(screen, [4][2]float64{{x1, y1}, {x2, y1}, {x2, y2}, {x1, y2}}, m.FocusColor)
+}
+
+func (m *Minimap) drawQuad(screen *ebiten.Image, quad [4][2]float64, clr color.Color) {
+	for i := range quad {
+		next := quad[(i+1)%4]
+		x1, y1 := m.toMinimap(quad[i][0], quad[i][1])
+		x2, y2 := m.toMinimap(next[0], next[1])
+		ebitenutil.DrawLine(screen, x1, y1, x2, y2, clr)
+	}
+}
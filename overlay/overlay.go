@@ -5,36 +5,50 @@ import (
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
 )
 
 // Overlay is like a Static Screen. (No Shake, No Move)
+// Drawing is same as screen.Screen (Canvas), coordinates are always Overlay pixels
 type Overlay interface {
-	DrawImage(image *ebiten.Image, op *ebiten.DrawImageOptions)
+	scr.Canvas
+	DrawBatch(batch *scr.SpriteBatch)
+	Clear()
+	SetAutoClear(autoClear bool)
+	SetDebug(debugOn bool)
+	GetImage() (overlayImage *ebiten.Image)
+	Size() (width, height int)
 	Render(screen *ebiten.Image)
 }
 
 // Can be used for Overlay, Effects or Transitions
+// AutoClear (default) clears Image after every Render, so Overlay is redrawn each frame
+// Without AutoClear drawings are retained till Clear (static HUD drawn once)
 type StaticScreen struct {
 	Width       int
 	Height      int
 	Image       *ebiten.Image
 	DrawOP      *ebiten.DrawImageOptions
 	Debug       bool
-	AutoScaling bool
+	AutoScaling bool // Scales Image to render target resolution on Render (same as CustomScreen)
+	AutoClear   bool
 }
 
 func New(width, height int) Overlay {
-	screenImg := ebiten.NewImage(width, height)
-
-	// To Test
-	screenImg.Fill(color.RGBA{64, 220, 14, 64})
+	return newStatic(width, height)
+}
 
+func newStatic(width, height int) *StaticScreen {
 	return &StaticScreen{
-		Image:       screenImg,
+		Image:       ebiten.NewImage(width, height),
 		Width:       width,
 		Height:      height,
 		DrawOP:      &ebiten.DrawImageOptions{},
 		AutoScaling: true,
+		AutoClear:   true,
 	}
 }
 
@@ -42,7 +56,7 @@ func (s *StaticScreen) Render(screen *ebiten.Image) {
 	s.DrawOP.GeoM.Reset()
 
 	// Scaling Screen Image to Render Resolution
-	if !s.AutoScaling {
+	if s.AutoScaling {
 		resX, resY := screen.Bounds().Dx(), screen.Bounds().Dy()
 		if resX != s.Width || resY != s.Height {
 			scaleX, scaleY := float64(resX)/float64(s.Width), float64(resY)/float64(s.Height)
@@ -51,6 +65,38 @@ func (s *StaticScreen) Render(screen *ebiten.Image) {
 	}
 
 	screen.DrawImage(s.Image, s.DrawOP)
+
+	if s.Debug {
+		x2, y2 := s.DrawOP.GeoM.Apply(float64(s.Width), float64(s.Height))
+		ebitenutil.DrawLine(screen, 0, 0, x2, 0, color.RGBA{0, 255, 0, 255})
+		ebitenutil.DrawLine(screen, 1, 0, 1, y2, color.RGBA{0, 255, 0, 255})
+		ebitenutil.DrawLine(screen, x2, 0, x2, y2, color.RGBA{0, 255, 0, 255})
+		ebitenutil.DrawLine(screen, 0, y2-1, x2, y2-1, color.RGBA{0, 255, 0, 255})
+	}
+
+	if s.AutoClear {
+		s.Clear()
+	}
+}
+
+func (s *StaticScreen) Clear() {
+	s.Image.Clear()
+}
+
+func (s *StaticScreen) SetAutoClear(autoClear bool) {
+	s.AutoClear = autoClear
+}
+
+func (s *StaticScreen) SetDebug(debugOn bool) {
+	s.Debug = debugOn
+}
+
+func (s *StaticScreen) GetImage() *ebiten.Image {
+	return s.Image
+}
+
+func (s *StaticScreen) Size() (int, int) {
+	return s.Width, s.Height
 }
 
 func (s *StaticScreen) Fill(col color.Color) {
@@ -61,6 +107,10 @@ func (s *StaticScreen) DrawImage(image *ebiten.Image, op *ebiten.DrawImageOption
 	s.Image.DrawImage(image, op)
 }
 
+func (s *StaticScreen) DrawBatch(batch *scr.SpriteBatch) {
+	batch.Draw(s.Image, ebiten.GeoM{})
+}
+
 func (s *StaticScreen) DrawLine(x1, y1, x2, y2 float64, col color.Color) {
 	ebitenutil.DrawLine(s.Image, x1, y1, x2, y2, col)
 }
@@ -85,3 +135,7 @@ func (s *StaticScreen) DebugPrint(text string) {
 func (s *StaticScreen) DebugPrintAt(text string, x, y int) {
 	ebitenutil.DebugPrintAt(s.Image, text, x, y)
 }
+
+func (s *StaticScreen) DrawText(txt string, fnt font.Face, x, y int, clr color.Color) {
+	text.Draw(s.Image, txt, fnt, x, y, clr)
+}
@@ -0,0 +1,236 @@
+package overlay
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
+	"github.com/shubhamdwivedii/scene-engine/ease"
+)
+
+type TransitionKind int
+
+const (
+	Fade      TransitionKind = iota // Outgoing fades to Color, then Color fades to incoming
+	Crossfade                       // Incoming fades in over outgoing
+	Wipe                            // Incoming is uncovered in place from an edge (see Direction)
+	Iris                            // Incoming is revealed through a growing circle
+	Dissolve                        // Incoming is revealed in random blocks
+)
+
+type Direction int
+
+const (
+	WipeRight Direction = iota // Reveals from left edge towards right
+	WipeLeft
+	WipeDown
+	WipeUp
+)
+
+const (
+	IRIS_SEGMENTS = 64
+	DISSOLVE_CELL = 8 // Pixels
+)
+
+// Iris and edge cells of Dissolve go past the incoming image, out-of-range sampling must be transparent
+var revealOP = &ebiten.DrawTrianglesOptions{Address: ebiten.AddressClampToZero}
+
+// Transition renders between an outgoing and incoming image (usually two scenes)
+// It composites on its own StaticScreen, so it works at any render target size
+type Transition struct {
+	Kind       TransitionKind
+	Duration   float64   // Seconds
+	Easing     ease.Func // Linear if nil
+	Color      color.Color
+	Direction  Direction
+	Clock      clk.Clock
+	OnMidpoint func() // Called once when half of Duration has elapsed (Fade is fully covered then, unless eased asymmetrically)
+	OnComplete func() // Called once when Duration has elapsed
+	Screen     *StaticScreen
+	elapsed    float64
+	midpoint   bool
+	done       bool
+	thresholds []float64 // Dissolve: random reveal point of each cell
+	vertices   []ebiten.Vertex
+	indices    []uint16
+}
+
+func NewTransition(kind TransitionKind, width, height int, duration float64) *Transition {
+	t := &Transition{
+		Kind:     kind,
+		Duration: duration,
+		Color:    color.Black,
+		Clock:    clk.Default,
+		Screen:   newStatic(width, height),
+	}
+	if kind == Dissolve {
+		cols, rows := (width+DISSOLVE_CELL-1)/DISSOLVE_CELL, (height+DISSOLVE_CELL-1)/DISSOLVE_CELL
+		t.thresholds = make([]float64, cols*rows)
+		for i := range t.thresholds {
+			t.thresholds[i] = rand.Float64()
+		}
+	}
+	return t
+}
+
+// Fraction of Duration elapsed (0.0 to 1.0), not eased
+func (t *Transition) Elapsed() float64 {
+	if t.Duration <= 0 {
+		return 1
+	}
+	return math.Min(1, t.elapsed/t.Duration)
+}
+
+// Eased progress from 0.0 (all outgoing) to 1.0 (all incoming)
+// Clamped, so overshooting easings (ease.OutBack) never go past the incoming image
+func (t *Transition) Progress() float64 {
+	return math.Max(0, math.Min(1, ease.Apply(t.Easing, t.Elapsed())))
+}
+
+func (t *Transition) Done() bool {
+	return t.done
+}
+
+func (t *Transition) Update() error {
+	if t.done {
+		return nil
+	}
+	// Timing is decided on elapsed time, easing only changes how it looks
+	t.elapsed += t.Clock.Delta()
+	progress := t.Elapsed()
+	if !t.midpoint && progress >= 0.5 {
+		t.midpoint = true
+		if t.OnMidpoint != nil {
+			t.OnMidpoint()
+		}
+	}
+	if progress >= 1 {
+		t.done = true
+		if t.OnComplete != nil {
+			t.OnComplete()
+		}
+	}
+	return nil
+}
+
+// from and to should be the size of the transition (Screen.Width x Screen.Height)
+func (t *Transition) Render(screen, from, to *ebiten.Image) {
+	p := t.Progress()
+	t.Screen.Clear()
+	dst := t.Screen.Image
+
+	switch t.Kind {
+	case Fade:
+		r, g, b, _ := t.Color.RGBA()
+		alpha := 2 * p
+		source := from
+		if p >= 0.5 {
+			alpha = 2 * (1 - p)
+			source = to
+		}
+		dst.DrawImage(source, nil)
+		ebitenutil.DrawRect(dst, 0, 0, float64(t.Screen.Width), float64(t.Screen.Height), color.RGBA64{
+			uint16(float64(r) * alpha), uint16(float64(g) * alpha), uint16(float64(b) * alpha), uint16(0xffff * alpha),
+		})
+	case Crossfade:
+		dst.DrawImage(from, nil)
+		op := &ebiten.DrawImageOptions{}
+		op.ColorM.Scale(1, 1, 1, p)
+		dst.DrawImage(to, op)
+	case Wipe:
+		dst.DrawImage(from, nil)
+		t.renderWipe(dst, to, p)
+	case Iris:
+		dst.DrawImage(from, nil)
+		t.renderIris(dst, to, p)
+	case Dissolve:
+		dst.DrawImage(from, nil)
+		t.renderDissolve(dst, to, p)
+	}
+
+	t.Screen.Render(screen)
+}
+
+func (t *Transition) renderWipe(dst, to *ebiten.Image, p float64) {
+	w, h := t.Screen.Width, t.Screen.Height
+	var area image.Rectangle
+	switch t.Direction {
+	case WipeLeft:
+		area = image.Rect(w-int(float64(w)*p), 0, w, h)
+	case WipeDown:
+		area = image.Rect(0, 0, w, int(float64(h)*p))
+	case WipeUp:
+		area = image.Rect(0, h-int(float64(h)*p), w, h)
+	default:
+		area = image.Rect(0, 0, int(float64(w)*p), h)
+	}
+	if area.Empty() {
+		return
+	}
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(float64(area.Min.X), float64(area.Min.Y))
+	dst.DrawImage(to.SubImage(area).(*ebiten.Image), op)
+}
+
+// Circle (triangle fan) textured with incoming image
+func (t *Transition) renderIris(dst, to *ebiten.Image, p float64) {
+	w, h := float64(t.Screen.Width), float64(t.Screen.Height)
+	cx, cy := w/2, h/2
+	radius := math.Hypot(w, h) / 2 * p
+	if radius <= 0 {
+		return
+	}
+
+	t.vertices = append(t.vertices[:0], vertex(cx, cy))
+	t.indices = t.indices[:0]
+	for i := 0; i <= IRIS_SEGMENTS; i++ {
+		angle := 2 * math.Pi * float64(i) / IRIS_SEGMENTS
+		t.vertices = append(t.vertices, vertex(cx+radius*math.Cos(angle), cy+radius*math.Sin(angle)))
+		if i > 0 {
+			t.indices = append(t.indices, 0, uint16(i), uint16(i+1))
+		}
+	}
+	dst.DrawTriangles(t.vertices, t.indices, to, revealOP)
+}
+
+// Cells with threshold below progress show incoming image
+func (t *Transition) renderDissolve(dst, to *ebiten.Image, p float64) {
+	cols := (t.Screen.Width + DISSOLVE_CELL - 1) / DISSOLVE_CELL
+	maxCells := ebiten.MaxIndicesNum / 6
+
+	t.vertices, t.indices = t.vertices[:0], t.indices[:0]
+	for i, threshold := range t.thresholds {
+		if threshold >= p {
+			continue
+		}
+		x, y := float64(i%cols*DISSOLVE_CELL), float64(i/cols*DISSOLVE_CELL)
+		base := uint16(len(t.vertices))
+		t.vertices = append(t.vertices,
+			vertex(x, y), vertex(x+DISSOLVE_CELL, y),
+			vertex(x, y+DISSOLVE_CELL), vertex(x+DISSOLVE_CELL, y+DISSOLVE_CELL),
+		)
+		t.indices = append(t.indices, base, base+1, base+2, base+1, base+3, base+2)
+
+		if len(t.indices)/6 == maxCells {
+			dst.DrawTriangles(t.vertices, t.indices, to, revealOP)
+			t.vertices, t.indices = t.vertices[:0], t.indices[:0]
+		}
+	}
+	if len(t.indices) > 0 {
+		dst.DrawTriangles(t.vertices, t.indices, to, revealOP)
+	}
+}
+
+// Source and destination are the same point (incoming image is drawn in place)
+func vertex(x, y float64) ebiten.Vertex {
+	return ebiten.Vertex{
+		DstX: float32(x), DstY: float32(y),
+		SrcX: float32(x), SrcY: float32(y),
+		ColorR: 1, ColorG: 1, ColorB: 1, ColorA: 1,
+	}
+}
@@ -0,0 +1,289 @@
+package scene
+
+import (
+	"errors"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	cam "github.com/shubhamdwivedii/scene-engine/camera"
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
+	ovr "github.com/shubhamdwivedii/scene-engine/overlay"
+	scr "github.com/shubhamdwivedii/scene-engine/screen"
+	vpt "github.com/shubhamdwivedii/scene-engine/viewport"
+)
+
+// Returned by Manager.Update when the last scene has been popped (ends ebiten.RunGame)
+var ErrEmptyStack = errors.New("scene stack is empty")
+
+type Scene interface {
+	Enter(manager *Manager) // Scene is pushed (or replaces another)
+	Exit()                  // Scene is popped (or replaced)
+	Update() error
+	Draw(screen *ebiten.Image)
+	Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int)
+}
+
+// Optional, called when another scene is pushed on top (Pause) or popped off (Resume)
+type Pausable interface {
+	Pause()
+	Resume()
+}
+
+// Optional, scene below a Transparent scene is drawn first (pause menu over the level)
+type Transparent interface {
+	Transparent() bool
+}
+
+// Manager is an ebiten.Game that runs the scene on top of the stack
+// Clock is game time (slow motion, pause, hit-stop), Transitions run on real time
+type Manager struct {
+	Clock      *clk.ScaledClock
+	stack      []Scene
+	transition *ovr.Transition
+	frame      *ebiten.Image // Last rendered frame (outgoing image of the next transition)
+	from       *ebiten.Image // Snapshot of outgoing scenes
+	to         *ebiten.Image // Incoming scenes (redrawn every frame)
+}
+
+func NewManager(first Scene) *Manager {
+	m := &Manager{Clock: clk.NewScaled(clk.Default)}
+	m.Push(first)
+	return m
+}
+
+func (m *Manager) Top() Scene {
+	if len(m.stack) == 0 {
+		return nil
+	}
+	return m.stack[len(m.stack)-1]
+}
+
+func (m *Manager) Len() int {
+	return len(m.stack)
+}
+
+func (m *Manager) Push(s Scene) {
+	if top, ok := m.Top().(Pausable); ok {
+		top.Pause()
+	}
+	m.stack = append(m.stack, s)
+	s.Enter(m)
+}
+
+// Returns popped scene (nil if stack is empty)
+func (m *Manager) Pop() Scene {
+	top := m.Top()
+	if top == nil {
+		return nil
+	}
+	m.stack = m.stack[:len(m.stack)-1]
+	top.Exit()
+	if below, ok := m.Top().(Pausable); ok {
+		below.Resume()
+	}
+	return top
+}
+
+// Swaps top scene (menu -> level), scene below is not resumed
+func (m *Manager) Replace(s Scene) {
+	if top := m.Top(); top != nil {
+		m.stack = m.stack[:len(m.stack)-1]
+		top.Exit()
+	}
+	m.stack = append(m.stack, s)
+	s.Enter(m)
+}
+
+// Same as Push, but the new scene is revealed through transition (plain Push if transition is nil)
+func (m *Manager) PushWith(s Scene, transition *ovr.Transition) {
+	m.startTransition(transition)
+	m.Push(s)
+}
+
+// Same as Pop, but the scene below is revealed through transition (plain Pop if transition is nil)
+func (m *Manager) PopWith(transition *ovr.Transition) Scene {
+	m.startTransition(transition)
+	return m.Pop()
+}
+
+// Same as Replace, but the new scene is revealed through transition (plain Replace if transition is nil)
+func (m *Manager) ReplaceWith(s Scene, transition *ovr.Transition) {
+	m.startTransition(transition)
+	m.Replace(s)
+}
+
+func (m *Manager) InTransition() bool {
+	return m.transition != nil
+}
+
+// Outgoing image is the last rendered frame (scenes aren't drawn again from Update)
+// Stack is changed right after
+func (m *Manager) startTransition(transition *ovr.Transition) {
+	if transition == nil {
+		return
+	}
+	w, h := transition.Screen.Width, transition.Screen.Height
+	if m.from == nil || m.from.Bounds().Dx() != w || m.from.Bounds().Dy() != h {
+		m.from = ebiten.NewImage(w, h)
+		m.to = ebiten.NewImage(w, h)
+	}
+	m.from.Clear()
+	if m.frame != nil {
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(float64(w)/float64(m.frame.Bounds().Dx()), float64(h)/float64(m.frame.Bounds().Dy()))
+		m.from.DrawImage(m.frame, op)
+	}
+	m.transition = transition
+}
+
+// New top scene keeps updating during a transition
+func (m *Manager) Update() error {
+	if m.transition != nil {
+		if err := m.transition.Update(); err != nil {
+			return err
+		}
+		if m.transition.Done() {
+			m.transition = nil
+		}
+	}
+	top := m.Top()
+	if top == nil {
+		return ErrEmptyStack
+	}
+	err := top.Update()
+	m.Clock.Tick()
+	return err
+}
+
+// Frame is rendered offscreen and kept, so a transition can start from it
+func (m *Manager) Draw(screen *ebiten.Image) {
+	w, h := screen.Bounds().Dx(), screen.Bounds().Dy()
+	if m.frame == nil || m.frame.Bounds().Dx() != w || m.frame.Bounds().Dy() != h {
+		m.frame = ebiten.NewImage(w, h)
+	}
+	m.frame.Clear()
+	if m.transition != nil {
+		m.to.Clear()
+		m.drawStack(m.to)
+		m.transition.Render(m.frame, m.from, m.to)
+	} else {
+		m.drawStack(m.frame)
+	}
+	screen.DrawImage(m.frame, nil)
+}
+
+func (m *Manager) drawStack(screen *ebiten.Image) {
+	if len(m.stack) == 0 {
+		return
+	}
+	// Start from the first scene visible through Transparent scenes on top
+	start := len(m.stack) - 1
+	for start > 0 {
+		transparent, ok := m.stack[start].(Transparent)
+		if !ok || !transparent.Transparent() {
+			break
+		}
+		start--
+	}
+	for _, s := range m.stack[start:] {
+		s.Draw(screen)
+	}
+}
+
+func (m *Manager) Layout(outsideWidth, outsideHeight int) (int, int) {
+	top := m.Top()
+	if top == nil {
+		return outsideWidth, outsideHeight
+	}
+	return top.Layout(outsideWidth, outsideHeight)
+}
+
+type Options struct {
+	ScreenWidth  int
+	ScreenHeight int
+	WorldWidth   int // Same as ScreenWidth for a static screen (no Viewport)
+	WorldHeight  int
+	Camera       bool
+	FocusWidth   int // Camera FocusView (ScreenWidth/3 if 0)
+	FocusHeight  int // (ScreenHeight/3 if 0)
+}
+
+// Base owns the Screen, Overlay, Camera and Viewport of a scene, embed it in your scenes
+// Override Update/Draw and call Base.Update/Base.Draw after updating/drawing your entities
+type Base struct {
+	Manager  *Manager
+	Screen   scr.Screen
+	Overlay  ovr.Overlay
+	Camera   *cam.Camera
+	Viewport *vpt.Viewport
+	Width    int
+	Height   int
+}
+
+func NewBase(opts Options) (*Base, error) {
+	b := &Base{
+		Width:  opts.ScreenWidth,
+		Height: opts.ScreenHeight,
+	}
+
+	static := opts.ScreenWidth == opts.WorldWidth && opts.ScreenHeight == opts.WorldHeight
+	if !static {
+		b.Viewport = vpt.New(
+			opts.ScreenWidth, opts.ScreenHeight, opts.WorldWidth, opts.WorldHeight,
+			float64(opts.WorldWidth)/2, float64(opts.WorldHeight)/2,
+		)
+	}
+
+	if opts.Camera {
+		focusW, focusH := opts.FocusWidth, opts.FocusHeight
+		if focusW == 0 {
+			focusW = opts.ScreenWidth / 3
+		}
+		if focusH == 0 {
+			focusH = opts.ScreenHeight / 3
+		}
+		b.Camera = cam.New(
+			opts.WorldWidth, opts.WorldHeight, focusW, focusH,
+			float64(opts.WorldWidth)/2, float64(opts.WorldHeight)/2,
+		)
+	}
+
+	var err error
+	b.Screen, err = scr.New(opts.ScreenWidth, opts.ScreenHeight, opts.WorldWidth, opts.WorldHeight, b.Viewport, b.Camera)
+	if err != nil {
+		return nil, err
+	}
+	b.Overlay = ovr.New(opts.ScreenWidth, opts.ScreenHeight)
+	return b, nil
+}
+
+// Screen and Camera run on Manager's game Clock (Overlay isn't time dependent)
+func (b *Base) Enter(manager *Manager) {
+	b.Manager = manager
+	b.Screen.SetClock(manager.Clock)
+	if b.Camera != nil {
+		b.Camera.SetClock(manager.Clock)
+	}
+}
+
+func (b *Base) Exit() {}
+
+// Updates Camera (after entities) and Screen
+func (b *Base) Update() error {
+	if b.Camera != nil {
+		if err := b.Camera.Update(); err != nil {
+			return err
+		}
+	}
+	return b.Screen.Update()
+}
+
+// Renders Screen then Overlay on top
+func (b *Base) Draw(screen *ebiten.Image) {
+	b.Screen.Render(screen)
+	b.Overlay.Render(screen)
+}
+
+func (b *Base) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return b.Width, b.Height
+}
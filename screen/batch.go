@@ -0,0 +1,144 @@
+package screen
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Sprites per DrawTriangles call (6 indices per sprite)
+const MAX_BATCH_SPRITES = ebiten.MaxIndicesNum / 6
+
+// Shared by all batches, indices repeat for every quad (TopLeft, TopRight, BottomLeft, BottomRight)
+var batchIndices []uint16
+
+func init() {
+	batchIndices = make([]uint16, 0, MAX_BATCH_SPRITES*6)
+	for i := 0; i < MAX_BATCH_SPRITES; i++ {
+		base := uint16(i * 4)
+		batchIndices = append(batchIndices, base, base+1, base+2, base+1, base+3, base+2)
+	}
+}
+
+// SpriteBatch collects many sub-image draws from one texture atlas
+// and submits them with a single DrawTriangles call (per MAX_BATCH_SPRITES sprites)
+// Transform is baked into vertices at Add time, set it to CustomScreen.GetOffsetMatrix() before adding sprites
+// so DrawBatch doesn't have to copy and offset every vertex each frame
+// If the offset changes later (reused/static batch), Draw applies only the difference (copying vertices)
+type SpriteBatch struct {
+	Atlas     *ebiten.Image
+	Filter    ebiten.Filter
+	Transform ebiten.GeoM // Baked into vertices when sprites are added (must be invertible)
+	vertices  []ebiten.Vertex
+	baked     ebiten.GeoM     // Transform the vertices were built with
+	scratch   []ebiten.Vertex // Transformed vertices (reused every Draw)
+}
+
+func NewSpriteBatch(atlas *ebiten.Image) *SpriteBatch {
+	return &SpriteBatch{
+		Atlas: atlas,
+	}
+}
+
+// src is area of the Atlas (in Atlas bounds), geoM places it in World coordinates (same as DrawImageOptions.GeoM)
+func (b *SpriteBatch) Add(src image.Rectangle, geoM ebiten.GeoM) {
+	b.AddColored(src, geoM, 1, 1, 1, 1)
+}
+
+// r, g, bl, a scale the sprite color (1 = original color)
+func (b *SpriteBatch) AddColored(src image.Rectangle, geoM ebiten.GeoM, r, g, bl, a float32) {
+	if b.Transform != b.baked {
+		// Transform changed after some sprites were added, re-bake them so all vertices share one Transform
+		transformVertices(b.vertices, b.relative(b.Transform))
+		b.baked = b.Transform
+	}
+	geoM.Concat(b.Transform)
+	w, h := float64(src.Dx()), float64(src.Dy())
+	sx0, sy0 := float32(src.Min.X), float32(src.Min.Y)
+	sx1, sy1 := float32(src.Max.X), float32(src.Max.Y)
+
+	corners := [4][4]float32{
+		{0, 0, sx0, sy0},
+		{float32(w), 0, sx1, sy0},
+		{0, float32(h), sx0, sy1},
+		{float32(w), float32(h), sx1, sy1},
+	}
+	for _, corner := range corners {
+		dx, dy := geoM.Apply(float64(corner[0]), float64(corner[1]))
+		b.vertices = append(b.vertices, ebiten.Vertex{
+			DstX:   float32(dx),
+			DstY:   float32(dy),
+			SrcX:   corner[2],
+			SrcY:   corner[3],
+			ColorR: r,
+			ColorG: g,
+			ColorB: bl,
+			ColorA: a,
+		})
+	}
+}
+
+func (b *SpriteBatch) Len() int {
+	return len(b.vertices) / 4
+}
+
+// Removes all sprites (keeps allocated memory for next frame)
+func (b *SpriteBatch) Reset() {
+	b.vertices = b.vertices[:0]
+	b.baked = b.Transform
+}
+
+// Matrix taking baked vertices to transform (identity if transform is what was baked)
+func (b *SpriteBatch) relative(transform ebiten.GeoM) ebiten.GeoM {
+	if transform == b.baked {
+		return ebiten.GeoM{}
+	}
+	m := b.baked
+	m.Invert()
+	m.Concat(transform)
+	return m
+}
+
+func transformVertices(vertices []ebiten.Vertex, m ebiten.GeoM) {
+	if m == (ebiten.GeoM{}) {
+		return
+	}
+	for i := range vertices {
+		x, y := m.Apply(float64(vertices[i].DstX), float64(vertices[i].DstY))
+		vertices[i].DstX, vertices[i].DstY = float32(x), float32(y)
+	}
+}
+
+// Draws all sprites on dst, transform is applied on top of each sprite's geoM
+// If transform isn't the baked Transform, the difference is applied to a copy of every vertex (4 per sprite)
+func (b *SpriteBatch) Draw(dst *ebiten.Image, transform ebiten.GeoM) {
+	if len(b.vertices) == 0 {
+		return
+	}
+	vertices := b.vertices
+	if m := b.relative(transform); m != (ebiten.GeoM{}) {
+		b.scratch = append(b.scratch[:0], b.vertices...)
+		transformVertices(b.scratch, m)
+		vertices = b.scratch
+	}
+
+	op := &ebiten.DrawTrianglesOptions{Filter: b.Filter}
+	for start := 0; start < len(vertices); start += MAX_BATCH_SPRITES * 4 {
+		end := start + MAX_BATCH_SPRITES*4
+		if end > len(vertices) {
+			end = len(vertices)
+		}
+		sprites := (end - start) / 4
+		dst.DrawTriangles(vertices[start:end], batchIndices[:sprites*6], b.Atlas, op)
+	}
+}
+
+// Takes coordinates based on Screen and Adjusts automatically for World (same as DrawImage)
+// Vertices are drawn as is if they were baked with the current GetOffsetMatrix() (see SpriteBatch.Transform)
+func (s *CustomScreen) DrawBatch(batch *SpriteBatch) {
+	batch.Draw(s.Image, s.GetOffsetMatrix())
+}
+
+func (s *SplitScreen) DrawBatch(batch *SpriteBatch) {
+	batch.Draw(s.Image, ebiten.GeoM{})
+}
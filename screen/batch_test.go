@@ -0,0 +1,153 @@
+package screen
+
+import (
+	"errors"
+	"flag"
+	"image"
+	"image/color"
+	"os"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	benchSprites = 10000
+	benchW       = 640
+	benchH       = 480
+)
+
+var errBenchmarksDone = errors.New("benchmarks done")
+
+// Drawing needs Ebiten's game loop, so benchmarks run inside Update (needs a display)
+// Tests run without the game loop when no -bench flag is given
+type benchGame struct {
+	m    *testing.M
+	code int
+}
+
+func (g *benchGame) Update() error {
+	g.code = g.m.Run()
+	return errBenchmarksDone
+}
+
+func (g *benchGame) Draw(screen *ebiten.Image) {}
+
+func (g *benchGame) Layout(outsideWidth, outsideHeight int) (int, int) {
+	return benchW, benchH
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if bench := flag.Lookup("test.bench"); bench == nil || bench.Value.String() == "" {
+		os.Exit(m.Run())
+	}
+	game := &benchGame{m: m}
+	if err := ebiten.RunGame(game); err != nil && !errors.Is(err, errBenchmarksDone) {
+		panic(err)
+	}
+	os.Exit(game.code)
+}
+
+// AutoPadding screen (non-identity offset, same as example/batch)
+func newBenchScreen(b *testing.B) (*CustomScreen, *ebiten.Image) {
+	s, err := New(benchW, benchH, benchW, benchH, nil, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	atlas := ebiten.NewImage(32, 32)
+	atlas.Fill(color.White)
+	return s.(*CustomScreen), atlas
+}
+
+func spriteGeoM(i int) ebiten.GeoM {
+	geoM := ebiten.GeoM{}
+	geoM.Translate(float64(i%benchW), float64(i/benchW*16%benchH))
+	return geoM
+}
+
+func BenchmarkDrawImage10k(b *testing.B) {
+	s, atlas := newBenchScreen(b)
+	op := &ebiten.DrawImageOptions{}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < benchSprites; i++ {
+			op.GeoM = spriteGeoM(i)
+			s.DrawImage(atlas, op)
+		}
+		s.Image.At(0, 0) // Flushes queued draw commands
+	}
+}
+
+func BenchmarkDrawBatch10k(b *testing.B) {
+	s, atlas := newBenchScreen(b)
+	batch := NewSpriteBatch(atlas)
+	src := atlas.Bounds()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		batch.Reset()
+		batch.Transform = s.GetOffsetMatrix()
+		for i := 0; i < benchSprites; i++ {
+			batch.Add(src, spriteGeoM(i))
+		}
+		s.DrawBatch(batch)
+		s.Image.At(0, 0) // Flushes queued draw commands
+	}
+}
+
+func translation(x, y float64) ebiten.GeoM {
+	m := ebiten.GeoM{}
+	m.Translate(x, y)
+	return m
+}
+
+// Top-left vertex of the only sprite after applying what Draw would apply for transform
+func drawnOrigin(b *SpriteBatch, transform ebiten.GeoM) (float64, float64) {
+	vertices := append([]ebiten.Vertex(nil), b.vertices...)
+	transformVertices(vertices, b.relative(transform))
+	return float64(vertices[0].DstX), float64(vertices[0].DstY)
+}
+
+func TestSpriteBatchOffsetChanges(t *testing.T) {
+	src := image.Rect(0, 0, 8, 8)
+	tests := []struct {
+		name         string
+		baked        ebiten.GeoM // Transform when sprite is added
+		drawn        ebiten.GeoM // Offset at Draw
+		wantX, wantY float64     // Sprite is added at world (100, 50)
+	}{
+		{"same offset", translation(20, 20), translation(20, 20), 120, 70},
+		{"offset moved after add", translation(20, 20), translation(-30, 5), 70, 55},
+		{"nothing baked", ebiten.GeoM{}, translation(-30, 5), 70, 55},
+		{"baked but drawn without offset", translation(20, 20), ebiten.GeoM{}, 100, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch := NewSpriteBatch(nil)
+			batch.Transform = tt.baked
+			batch.Add(src, translation(100, 50))
+			if x, y := drawnOrigin(batch, tt.drawn); x != tt.wantX || y != tt.wantY {
+				t.Errorf("drawn at (%v, %v), want (%v, %v)", x, y, tt.wantX, tt.wantY)
+			}
+		})
+	}
+}
+
+func TestSpriteBatchTransformChangedWhileAdding(t *testing.T) {
+	src := image.Rect(0, 0, 8, 8)
+	batch := NewSpriteBatch(nil)
+	batch.Transform = translation(20, 20)
+	batch.Add(src, translation(100, 50))
+	batch.Transform = translation(-10, 0)
+	batch.Add(src, translation(0, 0))
+
+	offset := translation(5, 5)
+	vertices := append([]ebiten.Vertex(nil), batch.vertices...)
+	transformVertices(vertices, batch.relative(offset))
+	want := [][2]float32{{105, 55}, {5, 5}}
+	for i, w := range want {
+		if v := vertices[i*4]; v.DstX != w[0] || v.DstY != w[1] {
+			t.Errorf("sprite %d drawn at (%v, %v), want (%v, %v)", i, v.DstX, v.DstY, w[0], w[1])
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package screen
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Coordinate spaces of the render pipeline:
+//   Window: Ebiten window (ebiten.WindowSize), Render target is letterboxed into it
+//   Render: Image passed to Render (size returned by Game.Layout), ebiten.CursorPosition is in this space
+//   Screen: ScreenWidth x ScreenHeight, Render target without AutoScaling
+//   World:  Coordinates passed to DrawImage/DrawRect etc. (before Camera offset and AutoPadding)
+// Conversions include Shake, so picking follows what is actually on screen.
+
+// CustomScreen.Image to Screen (Viewport or AutoPadding, then Shake)
+func (s *CustomScreen) screenMatrix() ebiten.GeoM {
+	m := ebiten.GeoM{}
+	if s.AutoPadding && s.Viewport == nil {
+		// Need To Render CustomScreen slightly off left/top (on RenderScreen) to adjust for AutoPadding
+		m.Translate(-AUTO_PADDING, -AUTO_PADDING)
+	} else {
+		m.Concat(s.Viewport.RenderMatrix())
+	}
+
+	// Shake is applied in screen space (rotation & zoom shake around center of screen)
+	m.Concat(s.shaker.matrix(float64(s.ScreenWidth)/2, float64(s.ScreenHeight)/2))
+	return m
+}
+
+// Screen to Render target (AutoScaling)
+func (s *CustomScreen) scalingMatrix() ebiten.GeoM {
+	m := ebiten.GeoM{}
+	if s.AutoScaling && (s.renderWidth != s.ScreenWidth || s.renderHeight != s.ScreenHeight) {
+		scaleX, scaleY := float64(s.renderWidth)/float64(s.ScreenWidth), float64(s.renderHeight)/float64(s.ScreenHeight)
+		m.Scale(scaleX, scaleY)
+	}
+	return m
+}
+
+// World to Screen (Camera offset & AutoPadding, then screenMatrix)
+func (s *CustomScreen) worldMatrix() ebiten.GeoM {
+	m := s.GetOffsetMatrix()
+	m.Concat(s.screenMatrix())
+	return m
+}
+
+// Render target is scaled to fit Window (keeping aspect ratio) and centered
+func (s *CustomScreen) windowMatrix() ebiten.GeoM {
+	m := ebiten.GeoM{}
+	windowW, windowH := ebiten.WindowSize()
+	if windowW <= 0 || windowH <= 0 || s.renderWidth <= 0 || s.renderHeight <= 0 {
+		return m
+	}
+	renderW, renderH := float64(s.renderWidth), float64(s.renderHeight)
+	scale := math.Min(float64(windowW)/renderW, float64(windowH)/renderH)
+	m.Scale(scale, scale)
+	m.Translate((float64(windowW)-renderW*scale)/2, (float64(windowH)-renderH*scale)/2)
+	return m
+}
+
+// Returns NaN, NaN if m is not invertible (when scaled to zero)
+func applyInverse(m ebiten.GeoM, x, y float64) (float64, float64) {
+	if !m.IsInvertible() {
+		return math.NaN(), math.NaN()
+	}
+	m.Invert()
+	return m.Apply(x, y)
+}
+
+func (s *CustomScreen) WindowToRender(x, y float64) (float64, float64) {
+	return applyInverse(s.windowMatrix(), x, y)
+}
+
+func (s *CustomScreen) RenderToWindow(x, y float64) (float64, float64) {
+	m := s.windowMatrix()
+	return m.Apply(x, y)
+}
+
+func (s *CustomScreen) RenderToScreen(x, y float64) (float64, float64) {
+	return applyInverse(s.scalingMatrix(), x, y)
+}
+
+func (s *CustomScreen) ScreenToRender(x, y float64) (float64, float64) {
+	m := s.scalingMatrix()
+	return m.Apply(x, y)
+}
+
+func (s *CustomScreen) ScreenToWorld(x, y float64) (float64, float64) {
+	return applyInverse(s.worldMatrix(), x, y)
+}
+
+func (s *CustomScreen) WorldToScreen(x, y float64) (float64, float64) {
+	m := s.worldMatrix()
+	return m.Apply(x, y)
+}
+
+// Use with ebiten.CursorPosition() for mouse picking
+func (s *CustomScreen) RenderToWorld(x, y float64) (float64, float64) {
+	return s.ScreenToWorld(s.RenderToScreen(x, y))
+}
+
+// Use to anchor UI (drawn on Render target or an Overlay) to World objects
+func (s *CustomScreen) WorldToRender(x, y float64) (float64, float64) {
+	return s.ScreenToRender(s.WorldToScreen(x, y))
+}
@@ -0,0 +1,60 @@
+package screen
+
+import (
+	"math"
+)
+
+// Culler can tell which part of the World is on screen (so off-screen draw calls can be skipped)
+type Culler interface {
+	VisibleWorldRect() (x, y, width, height float64)
+	IsVisible(x, y, width, height float64) bool
+}
+
+// Axis aligned World area currently on screen
+// Accounts for Camera offset, Viewport position, zoom & rotation and Shake
+func (s *CustomScreen) VisibleWorldRect() (x, y, width, height float64) {
+	w, h := float64(s.ScreenWidth), float64(s.ScreenHeight)
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, corner := range [4][2]float64{{0, 0}, {w, 0}, {w, h}, {0, h}} {
+		cx, cy := s.ScreenToWorld(corner[0], corner[1])
+		minX, maxX = math.Min(minX, cx), math.Max(maxX, cx)
+		minY, maxY = math.Min(minY, cy), math.Max(maxY, cy)
+	}
+	return minX, minY, maxX - minX, maxY - minY
+}
+
+// True if World rect (x, y, width, height) overlaps the visible area
+func (s *CustomScreen) IsVisible(x, y, width, height float64) bool {
+	vx, vy, vw, vh := s.VisibleWorldRect()
+	return overlaps(x, y, width, height, vx, vy, vw, vh)
+}
+
+// Union of the World area visible in every View
+func (s *SplitScreen) VisibleWorldRect() (x, y, width, height float64) {
+	if len(s.Views) == 0 {
+		return 0, 0, 0, 0
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, view := range s.Views {
+		x1, y1, x2, y2 := view.Viewport.VisibleBounds()
+		if view.Camera != nil {
+			// World image is drawn without Camera offset, offset moves the visible area instead
+			offx, offy := view.Camera.GetOffsets()
+			x1, y1, x2, y2 = x1-offx, y1-offy, x2-offx, y2-offy
+		}
+		minX, maxX = math.Min(minX, x1), math.Max(maxX, x2)
+		minY, maxY = math.Min(minY, y1), math.Max(maxY, y2)
+	}
+	return minX, minY, maxX - minX, maxY - minY
+}
+
+func (s *SplitScreen) IsVisible(x, y, width, height float64) bool {
+	vx, vy, vw, vh := s.VisibleWorldRect()
+	return overlaps(x, y, width, height, vx, vy, vw, vh)
+}
+
+func overlaps(x1, y1, w1, h1, x2, y2, w2, h2 float64) bool {
+	return x1 < x2+w2 && x2 < x1+w1 && y1 < y2+h2 && y2 < y1+h1
+}
@@ -0,0 +1,113 @@
+package screen
+
+import (
+	"image/color"
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+)
+
+// Layer is a separate image composited with CustomScreen.Image during Render
+// Parallax scales Camera and Viewport movement: 0 = Fixed (sky), 1 = Gameplay, >1 = Foreground
+// Z orders layers, CustomScreen.Image is at Z = 0 (Z < 0 is drawn behind it, Z >= 0 in front)
+// Layers are retained (not cleared on Render), Fill or Clear them if they change every frame
+type Layer struct {
+	Name     string
+	Parallax float64
+	Z        int
+	Image    *ebiten.Image
+	Hidden   bool
+	drawOP   *ebiten.DrawImageOptions
+}
+
+// Layer coordinates are World coordinates when Parallax is 1.0 (no Camera offset or padding needed)
+func (s *CustomScreen) AddLayer(name string, width, height int, parallax float64, z int) *Layer {
+	layer := &Layer{
+		Name:     name,
+		Parallax: parallax,
+		Z:        z,
+		Image:    ebiten.NewImage(width, height),
+		drawOP:   &ebiten.DrawImageOptions{},
+	}
+	s.Layers = append(s.Layers, layer)
+	sort.SliceStable(s.Layers, func(i, j int) bool {
+		return s.Layers[i].Z < s.Layers[j].Z
+	})
+	return layer
+}
+
+// Returns nil if there is no layer with that name
+func (s *CustomScreen) GetLayer(name string) *Layer {
+	for _, layer := range s.Layers {
+		if layer.Name == name {
+			return layer
+		}
+	}
+	return nil
+}
+
+// Layer to Screen, Camera offset and Viewport position are scaled by Parallax
+func (s *CustomScreen) layerMatrix(layer *Layer) ebiten.GeoM {
+	m := ebiten.GeoM{}
+	if s.Camera != nil {
+		offx, offy := s.Camera.GetOffsets()
+		m.Translate(offx*layer.Parallax, offy*layer.Parallax)
+	}
+	// With AutoPadding, padding offset and its removal cancel out
+	if !s.AutoPadding || s.Viewport != nil {
+		m.Concat(s.Viewport.ParallaxMatrix(layer.Parallax))
+	}
+	m.Concat(s.shaker.matrix(float64(s.ScreenWidth)/2, float64(s.ScreenHeight)/2))
+	return m
+}
+
+// Draws layers with from <= Z < to onto render target
+func (s *CustomScreen) renderLayers(screen *ebiten.Image, from, to int) {
+	for _, layer := range s.Layers {
+		if layer.Hidden || layer.Z < from || layer.Z >= to {
+			continue
+		}
+		layer.drawOP.GeoM = s.layerMatrix(layer)
+		layer.drawOP.GeoM.Concat(s.scalingMatrix())
+		screen.DrawImage(layer.Image, layer.drawOP)
+	}
+}
+
+func (l *Layer) Clear() {
+	l.Image.Clear()
+}
+
+func (l *Layer) Fill(col color.Color) {
+	l.Image.Fill(col)
+}
+
+func (l *Layer) DrawImage(image *ebiten.Image, op *ebiten.DrawImageOptions) {
+	l.Image.DrawImage(image, op)
+}
+
+func (l *Layer) DrawLine(x1, y1, x2, y2 float64, col color.Color) {
+	ebitenutil.DrawLine(l.Image, x1, y1, x2, y2, col)
+}
+
+func (l *Layer) DrawRect(x, y, width, height float64, solid bool, clr color.Color) {
+	if solid {
+		ebitenutil.DrawRect(l.Image, x, y, width, height, clr)
+	} else {
+		drawOutline(l.Image, x, y, x+width, y+height, clr)
+	}
+}
+
+func (l *Layer) DebugPrint(text string) {
+	ebitenutil.DebugPrint(l.Image, text)
+}
+
+func (l *Layer) DebugPrintAt(text string, x, y int) {
+	ebitenutil.DebugPrintAt(l.Image, text, x, y)
+}
+
+func (l *Layer) DrawText(txt string, fnt font.Face, x, y int, clr color.Color) {
+	text.Draw(l.Image, txt, fnt, x, y, clr)
+}
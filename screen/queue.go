@@ -0,0 +1,76 @@
+package screen
+
+import (
+	"sort"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Deferred draw call, flushed at Render in order of Z
+// Within same Z, commands without YSort are drawn first (in call order), then YSort commands by SortY
+// (higher SortY is drawn later, so characters lower on screen appear in front)
+// Z only orders queued commands among themselves: the whole queue is flushed after every immediate
+// draw (DrawImage, DrawRect, ...), so even a negative Z command appears in front of immediate draws
+// Queue the background as well if something has to be drawn behind it (or use a Layer with negative Z)
+type DrawCommand struct {
+	Z     int
+	SortY float64
+	YSort bool
+	Draw  func()
+}
+
+// Embedded by CustomScreen and SplitScreen, queued commands draw on canvas
+type renderQueue struct {
+	canvas   Canvas
+	commands []DrawCommand
+}
+
+func newRenderQueue(canvas Canvas) *renderQueue {
+	return &renderQueue{canvas: canvas}
+}
+
+// draw can call any Draw method of the Screen (it runs at Render time)
+func (q *renderQueue) Queue(z int, draw func()) {
+	q.commands = append(q.commands, DrawCommand{Z: z, Draw: draw})
+}
+
+// sortY is usually the bottom (feet) of the entity in World coordinates
+func (q *renderQueue) QueueYSorted(z int, sortY float64, draw func()) {
+	q.commands = append(q.commands, DrawCommand{Z: z, SortY: sortY, YSort: true, Draw: draw})
+}
+
+// op is copied, so it can be reused after this call
+func (q *renderQueue) QueueImage(image *ebiten.Image, op *ebiten.DrawImageOptions, z int) {
+	opCopy := *op
+	q.Queue(z, func() {
+		q.canvas.DrawImage(image, &opCopy)
+	})
+}
+
+func (q *renderQueue) QueueImageYSorted(image *ebiten.Image, op *ebiten.DrawImageOptions, z int, sortY float64) {
+	opCopy := *op
+	q.QueueYSorted(z, sortY, func() {
+		q.canvas.DrawImage(image, &opCopy)
+	})
+}
+
+// Executes queued commands in sorted order and empties the queue
+func (q *renderQueue) flush() {
+	if len(q.commands) == 0 {
+		return
+	}
+	sort.SliceStable(q.commands, func(i, j int) bool {
+		a, b := q.commands[i], q.commands[j]
+		if a.Z != b.Z {
+			return a.Z < b.Z
+		}
+		if a.YSort != b.YSort {
+			return !a.YSort
+		}
+		return a.YSort && a.SortY < b.SortY
+	})
+	for _, command := range q.commands {
+		command.Draw()
+	}
+	q.commands = q.commands[:0]
+}
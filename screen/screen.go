@@ -5,15 +5,16 @@ import (
 	"fmt"
 	"image/color"
 	_ "image/png"
+	"math"
 	"math/rand"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
-	"github.com/peterhellberg/gfx"
 	"golang.org/x/image/font"
 
 	cam "github.com/shubhamdwivedii/scene-engine/camera"
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
 	vpt "github.com/shubhamdwivedii/scene-engine/viewport"
 )
 
@@ -27,14 +28,45 @@ func init() {
 
 type Screen interface {
 	Shake()
+	AddTrauma(amount float64)
+	ShakeImpulse(dirX, dirY, strength, duration float64)
 	SetShakeIntensity(intensity float64)
+	SetShakeProfile(profile ShakeProfile)
+	GetShakeProfile() (profile ShakeProfile)
 	SetDebug(debugOn bool)
+	SetClock(clock clk.Clock)
 	Update() error
 	Render(screen *ebiten.Image)
 	GetImage() (screenImage *ebiten.Image)
 	GetViewport() (viewport *vpt.Viewport)
 	GetCamera() (camera *cam.Camera)
+	GetOffsets() (dx, dy float64)
+	GetOffsetMatrix() (offsetMatrix ebiten.GeoM)
+	AddLayer(name string, width, height int, parallax float64, z int) (layer *Layer)
+	GetLayer(name string) (layer *Layer)
+
+	// Queued draws are drawn after all immediate draws, in Z order (see DrawCommand)
+	Queue(z int, draw func())
+	QueueYSorted(z int, sortY float64, draw func())
+	QueueImage(image *ebiten.Image, op *ebiten.DrawImageOptions, z int)
+	QueueImageYSorted(image *ebiten.Image, op *ebiten.DrawImageOptions, z int, sortY float64)
+	DrawBatch(batch *SpriteBatch)
+
+	WindowToRender(x, y float64) (renderX, renderY float64)
+	RenderToWindow(x, y float64) (windowX, windowY float64)
+	RenderToScreen(x, y float64) (screenX, screenY float64)
+	ScreenToRender(x, y float64) (renderX, renderY float64)
+	ScreenToWorld(x, y float64) (worldX, worldY float64)
+	WorldToScreen(x, y float64) (screenX, screenY float64)
+	RenderToWorld(x, y float64) (worldX, worldY float64)
+	WorldToRender(x, y float64) (renderX, renderY float64)
+
+	Canvas
+	Culler
+}
 
+// Canvas is anything entities can draw on (CustomScreen, SplitScreen)
+type Canvas interface {
 	DrawImage(image *ebiten.Image, op *ebiten.DrawImageOptions)
 	DrawLine(x1, y1, x2, y2 float64, col color.Color)
 	DrawRect(x, y, width, height float64, fill bool, col color.Color)
@@ -51,18 +83,21 @@ type CustomScreen struct {
 	WorldHeight  int
 	// Offset            f64.Vec2
 	// OffsetMatrix      ebiten.GeoM
-	Image             *ebiten.Image
-	Viewport          *vpt.Viewport
-	Camera            *cam.Camera
-	MaxShakeIntensity float64
-	ShakeIntensity    float64
-	ShakeDuration     float64
-	DrawOP            *ebiten.DrawImageOptions
-	Debug             bool
-	AutoScaling       bool // Automatically Scales To Target Screen Resolution on Render
-	AutoPadding       bool
-	StaticViewport    bool
-	StaticCamera      bool
+	Image          *ebiten.Image
+	Viewport       *vpt.Viewport
+	Camera         *cam.Camera
+	DrawOP         *ebiten.DrawImageOptions
+	Debug          bool
+	AutoScaling    bool // Automatically Scales To Target Screen Resolution on Render
+	AutoPadding    bool
+	StaticViewport bool
+	StaticCamera   bool
+	Clock          clk.Clock
+	Layers         []*Layer // Sorted by Z
+	*renderQueue
+	shaker       *shaker
+	renderWidth  int // Size of render target in last Render (used for coordinate conversion)
+	renderHeight int
 }
 
 type ScreenOptions struct {
@@ -93,71 +128,104 @@ func New(screenWidth, screenHeight, worldWidth, worldHeight int, viewport *vpt.V
 	// offsetMatrix := ebiten.GeoM{}
 	// offsetMatrix.Translate(offx, offy)
 
-	return &CustomScreen{
-		Image:             screenImg,
-		ScreenWidth:       screenWidth,
-		ScreenHeight:      screenHeight,
-		WorldWidth:        worldWidth,
-		WorldHeight:       worldHeight,
-		Viewport:          viewport,
-		Camera:            camera,
-		MaxShakeIntensity: 10.0,
-		ShakeIntensity:    1.0,
-		ShakeDuration:     1.0,
-		DrawOP:            &ebiten.DrawImageOptions{},
-		AutoScaling:       true,
-		StaticViewport:    viewport == nil,
-		StaticCamera:      camera == nil,
-		AutoPadding:       autoPadding,
-	}, nil
+	s := &CustomScreen{
+		Image:          screenImg,
+		ScreenWidth:    screenWidth,
+		ScreenHeight:   screenHeight,
+		WorldWidth:     worldWidth,
+		WorldHeight:    worldHeight,
+		Viewport:       viewport,
+		Camera:         camera,
+		DrawOP:         &ebiten.DrawImageOptions{},
+		AutoScaling:    true,
+		StaticViewport: viewport == nil,
+		StaticCamera:   camera == nil,
+		AutoPadding:    autoPadding,
+		Clock:          clk.Default,
+		shaker:         newShaker(DefaultShakeProfile()),
+		renderWidth:    screenWidth,
+		renderHeight:   screenHeight,
+	}
+	s.renderQueue = newRenderQueue(s)
+	if camera != nil && viewport != nil {
+		camera.SetZoomer(viewport)
+	}
+	s.syncCameraView()
+	return s, nil
 }
 
 func (s *CustomScreen) SetDebug(debugOn bool) {
 	s.Debug = debugOn
-	if s.Camera != nil {
-		s.Camera.Debug = debugOn
-	}
 }
 
+// Adds ShakeProfile.TraumaPerShake, multiple calls stack up (till trauma reaches 1.0)
 func (s *CustomScreen) Shake() {
-	s.ShakeIntensity = 0.0
+	s.shaker.addTrauma(s.shaker.profile.TraumaPerShake)
+}
+
+// 1.0 = Max Shake, Trauma is clamped between 0.0 and 1.0
+func (s *CustomScreen) AddTrauma(amount float64) {
+	s.shaker.addTrauma(amount)
 }
 
-// 10.0 = Very Intense, 1.0  = Non Existent
+// Directional shake, screen is pushed towards (dirX, dirY) and springs back
+// strength is peak displacement in pixels, duration is in seconds
+// Concurrent impulses (and trauma shake) are summed
+func (s *CustomScreen) ShakeImpulse(dirX, dirY, strength, duration float64) {
+	s.shaker.addImpulse(dirX, dirY, strength, duration)
+}
+
+// Max X/Y displacement of ShakeProfile in pixels (10.0 = Very Intense, 1.0 = 1px shake, 0 = None)
 func (s *CustomScreen) SetShakeIntensity(maxIntensity float64) {
-	s.MaxShakeIntensity = maxIntensity
-	s.ShakeDuration = maxIntensity / 10.0
+	s.shaker.profile.MaxOffsetX = maxIntensity
+	s.shaker.profile.MaxOffsetY = maxIntensity
+}
+
+func (s *CustomScreen) SetShakeProfile(profile ShakeProfile) {
+	s.shaker.profile = profile
+}
+
+func (s *CustomScreen) GetShakeProfile() ShakeProfile {
+	return s.shaker.profile
+}
+
+// Screen reads Delta from this clock every Update (clock.Default if not set)
+func (s *CustomScreen) SetClock(clock clk.Clock) {
+	s.Clock = clock
 }
 
 func (s *CustomScreen) Update() error {
-	s.ShakeIntensity += 1 / 60.0 // 60 FPS fixed.
+	s.shaker.update(s.Clock.Delta())
+	s.syncCameraView()
 	return nil
 }
 
+// Keeps Camera aware of the visible area (used to clamp Camera to its Bounds)
+// Margin makes sure area revealed by shake (Viewport.Margin or AUTO_PADDING) is within Bounds too
+func (s *CustomScreen) syncCameraView() {
+	if s.Camera == nil {
+		return
+	}
+	if s.AutoPadding && s.Viewport == nil {
+		s.Camera.SetViewArea(0, 0, float64(s.ScreenWidth), float64(s.ScreenHeight), AUTO_PADDING)
+	} else {
+		// Zoomed/Rotated area actually visible through Viewport
+		x1, y1, x2, y2 := s.Viewport.VisibleBounds()
+		s.Camera.SetViewArea(x1, y1, x2-x1, y2-y1, s.Viewport.Margin)
+	}
+}
+
 // func (s *CustomScreen) AdjustForOffset(x, y float64) (float64, float64) {
 // 	return x + s.Offset[0], y + s.Offset[1]
 // }
 
 // Draws CustomScreen to RenderScreen
 func (s *CustomScreen) Render(screen *ebiten.Image) {
-	s.DrawOP.GeoM.Reset()
-
-	if s.ShakeIntensity < 1 {
-		lerped := gfx.Lerp(s.ShakeDuration, 0, s.ShakeIntensity)
-		amplitude := s.MaxShakeIntensity * lerped
-		dx := amplitude * (2*rand.Float64() - 1)
-		dy := amplitude * (2*rand.Float64() - 1)
-		s.DrawOP.GeoM.Translate(-dx, -dy)
-	}
+	// Deferred draw calls (z-index / y-sort) go in before debug stuff
+	s.flush()
 
-	if s.AutoPadding && s.Viewport == nil {
-		// Need To Render CustomScreen slightly off left/top (on RenderScreen) to adjust for AutoPadding
-		s.DrawOP.GeoM.Translate(-AUTO_PADDING, -AUTO_PADDING)
-
-	} else {
-		transformMatrix := s.Viewport.RenderMatrix()
-		s.DrawOP.GeoM.Concat(transformMatrix)
-	}
+	// Viewport (or AutoPadding) and Shake transforms
+	s.DrawOP.GeoM = s.screenMatrix()
 
 	if s.Debug {
 		// Debug stuff to render on game scene screen
@@ -173,33 +241,23 @@ func (s *CustomScreen) Render(screen *ebiten.Image) {
 	}
 
 	// Scaling Screen Image to Render Resolution
-	if s.AutoScaling {
-		resX, resY := screen.Bounds().Dx(), screen.Bounds().Dy()
-		if resX != s.ScreenWidth || resY != s.ScreenHeight {
-			scaleX, scaleY := float64(resX)/float64(s.ScreenWidth), float64(resY)/float64(s.ScreenHeight)
-			s.DrawOP.GeoM.Scale(scaleX, scaleY)
-		}
-	}
+	s.renderWidth, s.renderHeight = screen.Bounds().Dx(), screen.Bounds().Dy()
+	s.DrawOP.GeoM.Concat(s.scalingMatrix())
 
-	// Render Screen Image to Real Render Screen
+	// Render Screen Image (and Parallax Layers around it) to Real Render Screen
+	s.renderLayers(screen, math.MinInt32, 0)
 	screen.DrawImage(s.Image, s.DrawOP)
+	s.renderLayers(screen, 0, math.MaxInt32)
 
 	if s.Debug {
 		// Print debug content on real render screen
-		// worldX, worldY := s.Viewport.ScreenToWorld(ebiten.CursorPosition())
+		cursorX, cursorY := ebiten.CursorPosition()
+		worldX, worldY := s.RenderToWorld(float64(cursorX), float64(cursorY))
 
 		ebitenutil.DebugPrint(
 			screen,
-			fmt.Sprintf("TPS: %0.2f", ebiten.CurrentTPS()),
+			fmt.Sprintf("TPS: %0.2f\nCursor World Pos: %.2f,%.2f", ebiten.CurrentTPS(), worldX, worldY),
 		)
-
-		// ebitenutil.DebugPrintAt(
-		// 	screen,
-		// 	fmt.Sprintf("%s\nCursor World Pos: %.2f,%.2f",
-		// 		s.Viewport.String(),
-		// 		worldX, worldY),
-		// 	0, 240-32,
-		// )
 	}
 }
 
@@ -0,0 +1,182 @@
+package screen
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// ShakeProfile configures the trauma based screen shake.
+// Trauma (0.0 to 1.0) accumulates with every Shake and decays over time,
+// actual shake amplitude is trauma² (small hits barely shake, big hits shake a lot)
+type ShakeProfile struct {
+	MaxOffsetX     float64 // Max horizontal displacement in pixels
+	MaxOffsetY     float64 // Max vertical displacement in pixels
+	MaxAngle       float64 // Max rotation in radians (0 = No rotational shake)
+	MaxZoom        float64 // Max scale change, 0.05 = ±5% (0 = No zoom shake)
+	Frequency      float64 // Noise samples per second (higher is more violent)
+	Decay          float64 // Trauma removed per second
+	TraumaPerShake float64 // Trauma added by each Shake() call
+	ImpulseSpring  float64 // Oscillations per second of ShakeImpulse spring return
+}
+
+func DefaultShakeProfile() ShakeProfile {
+	return ShakeProfile{
+		MaxOffsetX:     10.0,
+		MaxOffsetY:     10.0,
+		Frequency:      25.0,
+		Decay:          1.0,
+		TraumaPerShake: 0.6,
+		ImpulseSpring:  8.0,
+	}
+}
+
+// Separate noise channels for each shake component (so they don't move in sync)
+// Offsets are non-integer, gradient noise is 0 at integers so channels would hit 0 together
+const (
+	channelX     = 0.0
+	channelY     = 63.37
+	channelAngle = 127.71
+	channelZoom  = 191.13
+)
+
+// Directional kick that springs back to rest (damped oscillation along direction)
+type impulse struct {
+	dirX, dirY float64 // Normalized direction of displacement
+	strength   float64 // Peak displacement in pixels
+	duration   float64 // Seconds until impulse dies out
+	elapsed    float64
+}
+
+// Envelope falls to ~1% of strength by the end of duration
+const impulseDamping = 4.6 // ln(100)
+
+// Normalized so the largest displacement (first swing) is exactly strength
+func (im *impulse) offset(spring float64) float64 {
+	peak := impulsePeak(spring, im.duration)
+	if peak <= 0 {
+		return 0
+	}
+	t := im.elapsed / im.duration
+	return im.strength / peak * math.Exp(-impulseDamping*t) * math.Sin(2*math.Pi*spring*im.elapsed)
+}
+
+// Max of exp(-a*t) * sin(w*t), reached at first swing t = atan(w/a)/w
+func impulsePeak(spring, duration float64) float64 {
+	a, w := impulseDamping/duration, 2*math.Pi*spring
+	if w <= 0 {
+		return 0
+	}
+	t := math.Atan(w/a) / w
+	return math.Exp(-a*t) * math.Sin(w*t)
+}
+
+type shaker struct {
+	profile  ShakeProfile
+	trauma   float64
+	time     float64
+	noise    *noise1D
+	impulses []impulse
+}
+
+func newShaker(profile ShakeProfile) *shaker {
+	return &shaker{
+		profile: profile,
+		noise:   newNoise1D(rand.Int63()),
+	}
+}
+
+func (sh *shaker) addTrauma(amount float64) {
+	sh.trauma = math.Max(0, math.Min(1, sh.trauma+amount))
+}
+
+// dirX, dirY is the direction screen is pushed towards (hit from left = (1, 0))
+func (sh *shaker) addImpulse(dirX, dirY, strength, duration float64) {
+	length := math.Hypot(dirX, dirY)
+	if length == 0 || duration <= 0 {
+		return
+	}
+	sh.impulses = append(sh.impulses, impulse{
+		dirX:     dirX / length,
+		dirY:     dirY / length,
+		strength: strength,
+		duration: duration,
+	})
+}
+
+func (sh *shaker) update(dt float64) {
+	sh.time += dt
+	sh.trauma = math.Max(0, sh.trauma-sh.profile.Decay*dt)
+
+	// Drop finished impulses (in place)
+	active := sh.impulses[:0]
+	for _, im := range sh.impulses {
+		im.elapsed += dt
+		if im.elapsed < im.duration {
+			active = append(active, im)
+		}
+	}
+	sh.impulses = active
+}
+
+// Returns current displacement (dx, dy in pixels, angle in radians, zoom as scale delta)
+// Trauma shake and all active impulses are summed
+func (sh *shaker) displacement() (dx, dy, angle, zoom float64) {
+	for i := range sh.impulses {
+		offset := sh.impulses[i].offset(sh.profile.ImpulseSpring)
+		dx += sh.impulses[i].dirX * offset
+		dy += sh.impulses[i].dirY * offset
+	}
+
+	if sh.trauma <= 0 {
+		return
+	}
+	amount := sh.trauma * sh.trauma
+	t := sh.time * sh.profile.Frequency
+	dx += sh.profile.MaxOffsetX * amount * sh.noise.at(t+channelX)
+	dy += sh.profile.MaxOffsetY * amount * sh.noise.at(t+channelY)
+	angle = sh.profile.MaxAngle * amount * sh.noise.at(t+channelAngle)
+	zoom = sh.profile.MaxZoom * amount * sh.noise.at(t+channelZoom)
+	return
+}
+
+// Shake transformation around (cx, cy), Concat this after the screen transform
+func (sh *shaker) matrix(cx, cy float64) ebiten.GeoM {
+	m := ebiten.GeoM{}
+	dx, dy, angle, zoom := sh.displacement()
+	if dx == 0 && dy == 0 && angle == 0 && zoom == 0 {
+		return m
+	}
+	m.Translate(-cx, -cy)
+	m.Scale(1+zoom, 1+zoom)
+	m.Rotate(angle)
+	m.Translate(cx+dx, cy+dy)
+	return m
+}
+
+// 1D Perlin (gradient) noise, smooth and continuous unlike rand.Float64()
+type noise1D struct {
+	gradients [256]float64
+}
+
+func newNoise1D(seed int64) *noise1D {
+	n := &noise1D{}
+	r := rand.New(rand.NewSource(seed))
+	for i := range n.gradients {
+		n.gradients[i] = 2*r.Float64() - 1
+	}
+	return n
+}
+
+// Returns noise value in range [-1, 1]
+func (n *noise1D) at(x float64) float64 {
+	x0 := math.Floor(x)
+	f := x - x0
+	i := int(x0) & 255
+	g0, g1 := n.gradients[i], n.gradients[(i+1)&255]
+	d0, d1 := g0*f, g1*(f-1)
+	u := f * f * f * (f*(f*6-15) + 10) // fade curve 6t⁵-15t⁴+10t³
+	// 1D gradient noise lies in [-0.5, 0.5]
+	return 2 * (d0 + u*(d1-d0))
+}
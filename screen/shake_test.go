@@ -0,0 +1,105 @@
+package screen
+
+import (
+	"math"
+	"testing"
+
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
+)
+
+func runShaker(sh *shaker, clock clk.Clock, ticks int) {
+	for i := 0; i < ticks; i++ {
+		sh.update(clock.Delta())
+	}
+}
+
+func TestShakerTraumaDecay(t *testing.T) {
+	tests := []struct {
+		name   string
+		tps    float64
+		ticks  int
+		trauma float64
+	}{
+		{"no ticks", 60, 0, 0.6},
+		{"half second at 60 TPS", 60, 30, 0.1},
+		{"half second at 30 TPS", 30, 15, 0.1},
+		{"half second at 120 TPS", 120, 60, 0.1},
+		{"fully decayed", 60, 60, 0},
+		{"clamped at zero", 60, 120, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sh := newShaker(DefaultShakeProfile()) // Decay 1.0 per second
+			sh.addTrauma(0.6)
+			runShaker(sh, clk.NewFake(1/tt.tps), tt.ticks)
+			if math.Abs(sh.trauma-tt.trauma) > 1e-9 {
+				t.Errorf("trauma = %v, want %v", sh.trauma, tt.trauma)
+			}
+		})
+	}
+}
+
+func TestShakerImpulseExpiry(t *testing.T) {
+	tests := []struct {
+		name   string
+		ticks  int
+		active int
+	}{
+		{"just added", 0, 1},
+		{"before duration", 29, 1},
+		{"after duration", 31, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sh := newShaker(DefaultShakeProfile())
+			sh.addImpulse(1, 0, 8, 0.5)
+			runShaker(sh, clk.NewFake(1.0/60), tt.ticks)
+			if len(sh.impulses) != tt.active {
+				t.Fatalf("active impulses = %d, want %d", len(sh.impulses), tt.active)
+			}
+			if tt.active == 0 {
+				if dx, dy, angle, zoom := sh.displacement(); dx != 0 || dy != 0 || angle != 0 || zoom != 0 {
+					t.Errorf("displacement = (%v, %v, %v, %v), want zero", dx, dy, angle, zoom)
+				}
+			}
+		})
+	}
+}
+
+func TestShakerDisplacementWithinProfile(t *testing.T) {
+	profile := DefaultShakeProfile()
+	profile.MaxAngle = 0.1
+	profile.MaxZoom = 0.05
+	sh := newShaker(profile)
+	clock := clk.NewFake(1.0 / 60)
+	for i := 0; i < 120; i++ {
+		sh.addTrauma(1)
+		sh.update(clock.Delta())
+		dx, dy, angle, zoom := sh.displacement()
+		if math.Abs(dx) > profile.MaxOffsetX || math.Abs(dy) > profile.MaxOffsetY ||
+			math.Abs(angle) > profile.MaxAngle || math.Abs(zoom) > profile.MaxZoom {
+			t.Fatalf("tick %d: displacement (%v, %v, %v, %v) exceeds profile", i, dx, dy, angle, zoom)
+		}
+	}
+}
+
+func TestImpulsePeakIsStrength(t *testing.T) {
+	tests := []struct {
+		spring, duration float64
+	}{
+		{8, 0.4}, // Default spring, example hit
+		{8, 0.6},
+		{2, 1},
+		{20, 0.2},
+	}
+	for _, tt := range tests {
+		im := impulse{dirX: 1, strength: 10, duration: tt.duration}
+		peak := 0.0
+		for im.elapsed = 0; im.elapsed < im.duration; im.elapsed += 1e-5 {
+			peak = math.Max(peak, math.Abs(im.offset(tt.spring)))
+		}
+		if math.Abs(peak-10) > 0.01 {
+			t.Errorf("spring %v, duration %v: peak = %v, want 10", tt.spring, tt.duration, peak)
+		}
+	}
+}
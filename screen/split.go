@@ -0,0 +1,303 @@
+package screen
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"golang.org/x/image/font"
+
+	cam "github.com/shubhamdwivedii/scene-engine/camera"
+	clk "github.com/shubhamdwivedii/scene-engine/clock"
+	vpt "github.com/shubhamdwivedii/scene-engine/viewport"
+)
+
+// Area of the render target in normalized coordinates (0.0 to 1.0)
+type Rect struct {
+	X, Y, W, H float64
+}
+
+// n views side by side (columns)
+func LayoutHorizontal(n int) []Rect {
+	rects := make([]Rect, n)
+	for i := range rects {
+		rects[i] = Rect{float64(i) / float64(n), 0, 1 / float64(n), 1}
+	}
+	return rects
+}
+
+// n views on top of each other (rows)
+func LayoutVertical(n int) []Rect {
+	rects := make([]Rect, n)
+	for i := range rects {
+		rects[i] = Rect{0, float64(i) / float64(n), 1, 1 / float64(n)}
+	}
+	return rects
+}
+
+// TopLeft, TopRight, BottomLeft, BottomRight
+func LayoutGrid2x2() []Rect {
+	return []Rect{
+		{0, 0, 0.5, 0.5},
+		{0.5, 0, 0.5, 0.5},
+		{0, 0.5, 0.5, 0.5},
+		{0.5, 0.5, 0.5, 0.5},
+	}
+}
+
+// First view is full size, second is inset at top-right corner
+// size is fraction of render target (0.3 = 30%), margin is fraction from the edges
+func LayoutPictureInPicture(size, margin float64) []Rect {
+	return []Rect{
+		{0, 0, 1, 1},
+		{1 - size - margin, margin, size, size},
+	}
+}
+
+// One pane of a SplitScreen, each View has its own Viewport, Camera and shake
+type View struct {
+	Viewport *vpt.Viewport
+	Camera   *cam.Camera
+	Rect     Rect
+	shaker   *shaker
+}
+
+func (v *View) Shake() {
+	v.shaker.addTrauma(v.shaker.profile.TraumaPerShake)
+}
+
+func (v *View) AddTrauma(amount float64) {
+	v.shaker.addTrauma(amount)
+}
+
+func (v *View) ShakeImpulse(dirX, dirY, strength, duration float64) {
+	v.shaker.addImpulse(dirX, dirY, strength, duration)
+}
+
+func (v *View) SetShakeProfile(profile ShakeProfile) {
+	v.shaker.profile = profile
+}
+
+func (v *View) GetShakeProfile() ShakeProfile {
+	return v.shaker.profile
+}
+
+// Area of the render target covered by this View (in pixels)
+func (v *View) Bounds(target *ebiten.Image) image.Rectangle {
+	return v.area(target.Bounds())
+}
+
+func (v *View) area(b image.Rectangle) image.Rectangle {
+	w, h := float64(b.Dx()), float64(b.Dy())
+	return image.Rect(
+		b.Min.X+int(v.Rect.X*w), b.Min.Y+int(v.Rect.Y*h),
+		b.Min.X+int((v.Rect.X+v.Rect.W)*w), b.Min.Y+int((v.Rect.Y+v.Rect.H)*h),
+	)
+}
+
+// Viewport is resized to the pixel size of its pane (so panes are never stretched)
+// Center of the Viewport is kept, so changing layout doesn't jump the view
+func (v *View) fit(bounds image.Rectangle) {
+	w, h := float64(bounds.Dx()), float64(bounds.Dy())
+	if w <= 0 || h <= 0 {
+		return
+	}
+	if v.Viewport.Dimensions[0] != w || v.Viewport.Dimensions[1] != h {
+		v.Viewport.Resize(w, h)
+	}
+}
+
+// World to View area of render target (Camera, Viewport and Shake, moved to Rect)
+func (v *View) matrix(bounds image.Rectangle) ebiten.GeoM {
+	m := ebiten.GeoM{}
+	if v.Camera != nil {
+		m.Concat(v.Camera.GetOffsetMatrix())
+	}
+	m.Concat(v.Viewport.RenderMatrix())
+	m.Concat(v.shaker.matrix(v.Viewport.Dimensions[0]/2, v.Viewport.Dimensions[1]/2))
+	m.Translate(float64(bounds.Min.X), float64(bounds.Min.Y))
+	return m
+}
+
+// SplitScreen renders one shared World image through several Views (split-screen, picture-in-picture)
+// Unlike CustomScreen, Camera offsets are applied at Render (so each View can have its own Camera)
+type SplitScreen struct {
+	ScreenWidth  int // Size of render target (Views are fitted to their pane of it)
+	ScreenHeight int
+	WorldWidth   int
+	WorldHeight  int
+	Image        *ebiten.Image
+	Views        []*View
+	Clock        clk.Clock
+	Debug        bool
+	*renderQueue
+}
+
+func NewSplit(screenWidth, screenHeight, worldWidth, worldHeight int) *SplitScreen {
+	s := &SplitScreen{
+		ScreenWidth:  screenWidth,
+		ScreenHeight: screenHeight,
+		WorldWidth:   worldWidth,
+		WorldHeight:  worldHeight,
+		Image:        ebiten.NewImage(worldWidth, worldHeight),
+		Clock:        clk.Default,
+	}
+	s.renderQueue = newRenderQueue(s)
+	return s
+}
+
+// Viewport is required, Camera can be nil
+func (s *SplitScreen) AddView(viewport *vpt.Viewport, camera *cam.Camera, rect Rect) (*View, error) {
+	if viewport == nil {
+		return nil, errors.New("viewport cannot be nil for a split-screen view")
+	}
+	if camera != nil {
+		camera.SetZoomer(viewport)
+	}
+	view := &View{
+		Viewport: viewport,
+		Camera:   camera,
+		Rect:     rect,
+		shaker:   newShaker(DefaultShakeProfile()),
+	}
+	s.Views = append(s.Views, view)
+	s.fitView(view)
+	return view, nil
+}
+
+// Assigns rects to Views in order (extra rects are ignored)
+// Viewports are resized to their new pane size right away (so next Update uses it)
+func (s *SplitScreen) SetLayout(rects []Rect) {
+	for i, view := range s.Views {
+		if i < len(rects) {
+			view.Rect = rects[i]
+			s.fitView(view)
+		}
+	}
+}
+
+// Render target size changed (Layout), every Viewport is resized to its pane
+func (s *SplitScreen) SetScreenSize(width, height int) {
+	s.ScreenWidth, s.ScreenHeight = width, height
+	for _, view := range s.Views {
+		s.fitView(view)
+	}
+}
+
+func (s *SplitScreen) fitView(view *View) {
+	view.fit(view.area(image.Rect(0, 0, s.ScreenWidth, s.ScreenHeight)))
+}
+
+func (s *SplitScreen) SetClock(clock clk.Clock) {
+	s.Clock = clock
+}
+
+func (s *SplitScreen) SetDebug(debugOn bool) {
+	s.Debug = debugOn
+}
+
+func (s *SplitScreen) Update() error {
+	dt := s.Clock.Delta()
+	for _, view := range s.Views {
+		view.shaker.update(dt)
+		if view.Camera != nil {
+			x1, y1, x2, y2 := view.Viewport.VisibleBounds()
+			view.Camera.SetViewArea(x1, y1, x2-x1, y2-y1, view.Viewport.Margin)
+		}
+	}
+	return nil
+}
+
+// Draws every View into its Rect of the render target
+func (s *SplitScreen) Render(screen *ebiten.Image) {
+	s.flush()
+	for i, view := range s.Views {
+		bounds := view.Bounds(screen)
+		if bounds.Empty() {
+			continue
+		}
+		view.fit(bounds) // No-op unless render target isn't ScreenWidth x ScreenHeight
+		pane := screen.SubImage(bounds).(*ebiten.Image)
+		matrix := view.matrix(bounds)
+		pane.DrawImage(s.Image, &ebiten.DrawImageOptions{GeoM: matrix})
+
+		if s.Debug {
+			s.drawViewDebug(pane, view, i, bounds, matrix)
+		}
+	}
+}
+
+// Border of the View and Camera focus area (drawn on render target, on top of the View)
+func (s *SplitScreen) drawViewDebug(pane *ebiten.Image, view *View, index int, bounds image.Rectangle, matrix ebiten.GeoM) {
+	x1, y1 := float64(bounds.Min.X), float64(bounds.Min.Y)
+	x2, y2 := float64(bounds.Max.X), float64(bounds.Max.Y)
+	drawOutline(pane, x1, y1, x2, y2, color.RGBA{255, 0, 0, 255})
+
+	label := fmt.Sprintf("View %d", index)
+	if view.Camera != nil {
+		fx := view.Camera.FocusCenter[0] - view.Camera.FocusView[0]/2
+		fy := view.Camera.FocusCenter[1] - view.Camera.FocusView[1]/2
+		corners := [4][2]float64{
+			{fx, fy}, {fx + view.Camera.FocusView[0], fy},
+			{fx + view.Camera.FocusView[0], fy + view.Camera.FocusView[1]}, {fx, fy + view.Camera.FocusView[1]},
+		}
+		// Same as CustomScreen, FocusCenter goes through Camera offset (so it appears static in View)
+		for c := range corners {
+			corners[c][0], corners[c][1] = matrix.Apply(corners[c][0], corners[c][1])
+		}
+		for c := range corners {
+			next := corners[(c+1)%4]
+			ebitenutil.DrawLine(pane, corners[c][0], corners[c][1], next[0], next[1], color.RGBA{0, 0, 255, 255})
+		}
+		label += fmt.Sprintf(" Camera-X: %0.2f Camera-Y: %0.2f", view.Camera.Position[0], view.Camera.Position[1])
+	}
+	ebitenutil.DebugPrintAt(pane, label, bounds.Min.X+2, bounds.Min.Y+2)
+}
+
+func drawOutline(dst *ebiten.Image, x1, y1, x2, y2 float64, clr color.Color) {
+	ebitenutil.DrawLine(dst, x1, y1, x2, y1, clr)
+	ebitenutil.DrawLine(dst, x1+1, y1, x1+1, y2, clr)
+	ebitenutil.DrawLine(dst, x2, y1, x2, y2, clr)
+	ebitenutil.DrawLine(dst, x1, y2-1, x2, y2-1, clr)
+}
+
+func (s *SplitScreen) GetImage() *ebiten.Image {
+	return s.Image
+}
+
+// Drawing is in World coordinates (no offsets, Cameras are applied per View at Render)
+func (s *SplitScreen) DrawImage(image *ebiten.Image, op *ebiten.DrawImageOptions) {
+	s.Image.DrawImage(image, op)
+}
+
+func (s *SplitScreen) Fill(col color.Color) {
+	s.Image.Fill(col)
+}
+
+func (s *SplitScreen) DrawLine(x1, y1, x2, y2 float64, col color.Color) {
+	ebitenutil.DrawLine(s.Image, x1, y1, x2, y2, col)
+}
+
+func (s *SplitScreen) DrawRect(x, y, width, height float64, solid bool, clr color.Color) {
+	if solid {
+		ebitenutil.DrawRect(s.Image, x, y, width, height, clr)
+	} else {
+		drawOutline(s.Image, x, y, x+width, y+height, clr)
+	}
+}
+
+func (s *SplitScreen) DebugPrint(text string) {
+	s.DebugPrintAt(text, 0, 0)
+}
+
+func (s *SplitScreen) DebugPrintAt(text string, x, y int) {
+	ebitenutil.DebugPrintAt(s.Image, text, x, y)
+}
+
+func (s *SplitScreen) DrawText(txt string, fnt font.Face, x, y int, clr color.Color) {
+	text.Draw(s.Image, txt, fnt, x, y, clr)
+}
@@ -15,11 +15,19 @@ type Viewport struct {
 	InitialPosition  f64.Vec2
 	WorldCenter      f64.Vec2
 	Margin           float64
-	ZoomFactor       int
-	Rotation         int
+	Scale            float64 // 1.0 is default (2.0 = 2x zoom in)
+	Angle            float64 // Rotation in radians
+	MinScale         float64
+	MaxScale         float64
 	AllowOutOfBounds bool
 }
 
+const (
+	ZOOM_STEP         = 1.01 // Scale change per ZoomFactor step (used by int based zoom)
+	DEFAULT_MIN_SCALE = 0.1
+	DEFAULT_MAX_SCALE = 10.0
+)
+
 // Viewport should have same dimenstions as Viewable Screen
 // CenterX and CenterY are center point of Viewport relative to World
 // (ww/2, wh/2) if viewport is at center of world initially
@@ -31,12 +39,24 @@ func New(screenWidth, screenHeight, worldWidth, worldHeight int, centerX, center
 		WorldCenter:     f64.Vec2{float64(worldWidth) / 2, float64(worldHeight) / 2},
 		Position:        f64.Vec2{posX, posY},
 		InitialPosition: f64.Vec2{posX, posY},
+		Scale:           1.0,
+		MinScale:        DEFAULT_MIN_SCALE,
+		MaxScale:        DEFAULT_MAX_SCALE,
 	}
 	// rest is zero valued
 }
 
+// Changes Dimensions keeping the same center (split-screen panes, render size changes)
+func (v *Viewport) Resize(width, height float64) {
+	cx, cy := v.GetCenter()
+	v.Dimensions = f64.Vec2{width, height}
+	v.Position = f64.Vec2{cx - width/2, cy - height/2}
+	v.SetScale(v.Scale) // Re-applies bounds with new dimensions
+}
+
 func (v *Viewport) SetMargin(margin float64) {
 	v.Margin = margin
+	v.SetScale(v.Scale) // Re-applies bounds with new margin
 }
 
 // Get Center point Of Viewport in the World
@@ -46,8 +66,8 @@ func (v *Viewport) GetCenter() (cx, cy float64) {
 
 func (v *Viewport) String() string {
 	return fmt.Sprintf(
-		"T: %.1f, R: %d, S: %d",
-		v.Position, v.Rotation, v.ZoomFactor,
+		"T: %.1f, R: %.2f, S: %.2f",
+		v.Position, v.Angle, v.Scale,
 	)
 }
 
@@ -59,42 +79,81 @@ func (v *Viewport) viewportCenter() f64.Vec2 {
 }
 
 func (v *Viewport) worldMatrix() ebiten.GeoM {
+	return v.parallaxMatrix(1.0)
+}
+
+// Viewport Position is scaled by factor (0 = doesn't move, 1 = moves with Viewport)
+func (v *Viewport) parallaxMatrix(factor float64) ebiten.GeoM {
 	m := ebiten.GeoM{}
-	m.Translate(-v.Position[0], -v.Position[1])
+	m.Translate(-v.Position[0]*factor, -v.Position[1]*factor)
 	// Scaling & Rotation is done around center of Screen/Image
 	m.Translate(-v.viewportCenter()[0], -v.viewportCenter()[1])
-	m.Scale(
-		math.Pow(1.01, float64(v.ZoomFactor)),
-		math.Pow(1.01, float64(v.ZoomFactor)),
-	)
-	m.Rotate(float64(v.Rotation) * 2 * math.Pi / 360)
+	m.Scale(v.Scale, v.Scale)
+	m.Rotate(v.Angle)
 	m.Translate(v.viewportCenter()[0], v.viewportCenter()[1])
 	return m
 }
 
-// Checks if Viewport is OutOfBounds (Outside WorldView)
-// Retuns dx, dy to adjust In-Bound
+// Checks if Viewport is OutOfBounds (Visible area is outside WorldView)
+// Visible area accounts for Scale and Angle (see VisibleQuad)
+// Retuns dx, dy to adjust In-Bound (centers on WorldView if visible area is too big)
 func (v *Viewport) OutOfBounds() (dx float64, dy float64) {
-	x1, y1 := v.Position[0], v.Position[1]
-	x2, y2 := x1+v.Dimensions[0], y1+v.Dimensions[1]
+	x1, y1, x2, y2 := v.VisibleBounds()
+	dx = boundsCorrection(x1, x2, v.Margin, v.WorldView[0]-v.Margin)
+	dy = boundsCorrection(y1, y2, v.Margin, v.WorldView[1]-v.Margin)
+	return dx, dy
+}
 
-	if x1 < v.Margin {
-		dx = v.Margin - x1
+func boundsCorrection(min, max, lo, hi float64) float64 {
+	if max-min > hi-lo {
+		return (lo+hi)/2 - (min+max)/2
 	}
-
-	if x2 > v.WorldView[0]-v.Margin {
-		dx = v.WorldView[0] - v.Margin - x2
+	if min < lo {
+		return lo - min
 	}
+	if max > hi {
+		return hi - max
+	}
+	return 0
+}
 
-	if y1 < v.Margin {
-		dy = v.Margin - y1
+// Corners of the screen in World coordinates (TopLeft, TopRight, BottomRight, BottomLeft)
+// This is the actual area visible after scaling and rotation
+func (v *Viewport) VisibleQuad() [4]f64.Vec2 {
+	w, h := v.Dimensions[0], v.Dimensions[1]
+	corners := [4]f64.Vec2{{0, 0}, {w, 0}, {w, h}, {0, h}}
+	for i, corner := range corners {
+		x, y := v.screenToWorld(corner[0], corner[1])
+		corners[i] = f64.Vec2{x, y}
 	}
+	return corners
+}
 
-	if y2 > v.WorldView[1]-v.Margin {
-		dy = v.WorldView[1] - v.Margin - y2
+// Axis aligned bounding box of VisibleQuad in World coordinates
+func (v *Viewport) VisibleBounds() (minX, minY, maxX, maxY float64) {
+	quad := v.VisibleQuad()
+	minX, minY = quad[0][0], quad[0][1]
+	maxX, maxY = minX, minY
+	for _, corner := range quad[1:] {
+		minX, maxX = math.Min(minX, corner[0]), math.Max(maxX, corner[0])
+		minY, maxY = math.Min(minY, corner[1]), math.Max(maxY, corner[1])
 	}
+	return
+}
 
-	return dx, dy
+// Smallest scale at which rotated screen fits inside WorldView (minus Margin)
+func (v *Viewport) minScale() float64 {
+	if v.AllowOutOfBounds {
+		return v.MinScale
+	}
+	availW, availH := v.WorldView[0]-2*v.Margin, v.WorldView[1]-2*v.Margin
+	if availW <= 0 || availH <= 0 {
+		return v.MinScale
+	}
+	cos, sin := math.Abs(math.Cos(v.Angle)), math.Abs(math.Sin(v.Angle))
+	w, h := v.Dimensions[0], v.Dimensions[1]
+	fit := math.Max((w*cos+h*sin)/availW, (w*sin+h*cos)/availH)
+	return math.Max(v.MinScale, fit)
 }
 
 func (v *Viewport) Render(world, screen *ebiten.Image) {
@@ -107,12 +166,28 @@ func (v *Viewport) RenderMatrix() ebiten.GeoM {
 	return v.worldMatrix()
 }
 
+// Same as RenderMatrix but Viewport movement is scaled by factor (for parallax layers)
+// Zoom and rotation are not scaled
+func (v *Viewport) ParallaxMatrix(factor float64) ebiten.GeoM {
+	return v.parallaxMatrix(factor)
+}
+
 // Converts Screen Coordinates to World Coordinates
 func (v *Viewport) ScreenToWorld(posX, posY int) (float64, float64) {
+	return v.screenToWorld(float64(posX), float64(posY))
+}
+
+// Converts World Coordinates to Screen Coordinates (inverse of ScreenToWorld)
+func (v *Viewport) WorldToScreen(worldX, worldY float64) (float64, float64) {
+	m := v.worldMatrix()
+	return m.Apply(worldX, worldY)
+}
+
+func (v *Viewport) screenToWorld(x, y float64) (float64, float64) {
 	inverseMatrix := v.worldMatrix()
 	if inverseMatrix.IsInvertible() {
 		inverseMatrix.Invert()
-		return inverseMatrix.Apply(float64(posX), float64(posY))
+		return inverseMatrix.Apply(x, y)
 	} else {
 		// when scaling its possible that matrix is not invertible
 		return math.NaN(), math.NaN()
@@ -122,49 +197,111 @@ func (v *Viewport) ScreenToWorld(posX, posY int) (float64, float64) {
 func (v *Viewport) Reset() {
 	v.Position[0] = v.InitialPosition[0]
 	v.Position[1] = v.InitialPosition[1]
-	v.Rotation = 0
-	v.ZoomFactor = 0
+	v.Angle = 0
+	v.SetScale(1.0)
 }
 
 // (0,0) is default origin
 func (v *Viewport) MoveTo(x, y float64) {
 	v.Position[0] = x
 	v.Position[1] = y
-
-	if !v.AllowOutOfBounds {
-		dx, dy := v.OutOfBounds()
-		v.Position[0] += dx
-		v.Position[1] += dy
-	}
+	v.keepInBounds()
 }
 
 func (v *Viewport) MoveBy(dx, dy float64) {
 	v.Position[0] += dx
 	v.Position[1] += dy
+	v.keepInBounds()
+}
 
-	if !v.AllowOutOfBounds {
-		odx, ody := v.OutOfBounds()
-		v.Position[0] += odx
-		v.Position[1] += ody
+// Zooms by factor keeping the World point under (screenX, screenY) fixed (mouse-wheel zoom)
+// factor > 1.0 zooms in, factor < 1.0 zooms out
+func (v *Viewport) ZoomAt(screenX, screenY, factor float64) {
+	wx, wy := v.screenToWorld(screenX, screenY)
+	v.ScaleBy(factor)
+	nx, ny := v.screenToWorld(screenX, screenY)
+	if math.IsNaN(wx) || math.IsNaN(nx) {
+		return
 	}
+	// Translation is applied before scale/rotation, so shifting Position shifts World point by same amount
+	v.Position[0] += wx - nx
+	v.Position[1] += wy - ny
+	v.keepInBounds()
 }
 
-func (v *Viewport) ZoomBy(dz int) {
-	if v.ZoomFactor > -2400 && v.ZoomFactor < 2400 {
-		v.ZoomFactor += dz
+// Pulls Viewport back inside WorldView (unless AllowOutOfBounds)
+func (v *Viewport) keepInBounds() {
+	if v.AllowOutOfBounds {
+		return
 	}
+	dx, dy := v.OutOfBounds()
+	v.Position[0] += dx
+	v.Position[1] += dy
 }
 
-// default z = 0
+// Scale is clamped between MinScale and MaxScale
+// Unless AllowOutOfBounds, scale is also limited so nothing outside WorldView becomes visible
+func (v *Viewport) SetScale(scale float64) {
+	v.Scale = math.Max(v.minScale(), math.Min(v.MaxScale, scale))
+	v.keepInBounds()
+}
+
+func (v *Viewport) GetScale() float64 {
+	return v.Scale
+}
+
+// Multiplies current scale by factor (2.0 = zoom in 2x)
+func (v *Viewport) ScaleBy(factor float64) {
+	v.SetScale(v.Scale * factor)
+}
+
+// Set Min/Max scale limits (current scale is clamped to new limits)
+func (v *Viewport) SetScaleLimits(minScale, maxScale float64) {
+	v.MinScale = minScale
+	v.MaxScale = maxScale
+	v.SetScale(v.Scale)
+}
+
+// Rotation in radians (may zoom in to keep rotated view inside WorldView)
+func (v *Viewport) SetAngle(radians float64) {
+	v.Angle = radians
+	v.SetScale(v.Scale)
+}
+
+func (v *Viewport) GetAngle() float64 {
+	return v.Angle
+}
+
+func (v *Viewport) RotateBy(radians float64) {
+	v.SetAngle(v.Angle + radians)
+}
+
+// Compatibility wrapper, each step is 1% (scale is multiplied by 1.01^dz)
+func (v *Viewport) ZoomBy(dz int) {
+	v.ScaleBy(math.Pow(ZOOM_STEP, float64(dz)))
+}
+
+// Compatibility wrapper, default z = 0 (scale = 1.01^z)
 func (v *Viewport) SetZoom(z int) {
-	v.ZoomFactor = z
+	v.SetScale(math.Pow(ZOOM_STEP, float64(z)))
+}
+
+// Compatibility wrapper for removed ZoomFactor field (nearest z where scale = 1.01^z)
+func (v *Viewport) GetZoom() int {
+	return int(math.Round(math.Log(v.Scale) / math.Log(ZOOM_STEP)))
+}
+
+// Compatibility wrapper for removed Rotation field (nearest whole degree)
+func (v *Viewport) GetRotation() int {
+	return int(math.Round(v.Angle * 360 / (2 * math.Pi)))
 }
 
-// default r = 0
+// Compatibility wrapper, default r = 0 (in degrees)
 func (v *Viewport) SetRotation(r int) {
-	v.Rotation = r
+	v.SetAngle(float64(r) * 2 * math.Pi / 360)
 }
 
+// Compatibility wrapper, dr is in degrees
 func (v *Viewport) RoatateBy(dr int) {
-	v.Rotation += dr
+	v.RotateBy(float64(dr) * 2 * math.Pi / 360)
 }